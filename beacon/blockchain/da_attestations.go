@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/attestation"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExtendVote returns the vote extension attesting that the validator with the
+// given CometBFT address holds and verified the blob sidecars of the proposal
+// it is precommitting. The extension is empty if the proposal was not
+// verified by this node.
+func (s *Service) ExtendVote(
+	ctx sdk.Context,
+	req *cmtabci.ExtendVoteRequest,
+	validatorAddress []byte,
+) ([]byte, error) {
+	blockRoot, ok := s.verifiedBlockRoot(req.Hash)
+	if !ok {
+		s.logger.Warn(
+			"Not attesting DA of a proposal that was not verified",
+			"height", req.Height, "hash", fmt.Sprintf("%X", req.Hash),
+		)
+		return nil, nil
+	}
+
+	st := s.storageBackend.StateFromContext(ctx)
+	index, _, err := s.validatorAddresses.resolve(st, validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	return attestation.New(req.Height, index, blockRoot).MarshalSSZ()
+}
+
+// VerifyVoteExtension verifies the DA attestation extending the precommit of
+// another validator. Empty extensions are accepted, so that validators which
+// did not verify the proposal, e.g. after a restart, can still precommit.
+func (s *Service) VerifyVoteExtension(
+	ctx sdk.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) error {
+	st := s.storageBackend.StateFromContext(ctx)
+	att, err := attestation.VerifyExtension(
+		req.VoteExtension,
+		req.Height,
+		req.ValidatorAddress,
+		func(cometBFTAddress []byte) (math.ValidatorIndex, crypto.BLSPubkey, error) {
+			return s.validatorAddresses.resolve(st, cometBFTAddress)
+		},
+	)
+	if err != nil || att == nil {
+		return err
+	}
+
+	// The attested root can only be checked against a proposal we verified.
+	if blockRoot, ok := s.verifiedBlockRoot(req.Hash); ok && att.GetBeaconBlockRoot() != blockRoot {
+		return fmt.Errorf("%w: root %s, block root %s",
+			attestation.ErrInvalidAttestation, att.GetBeaconBlockRoot(), blockRoot,
+		)
+	}
+	return nil
+}
+
+// BuildDAAttestationsTx returns the consensus transaction aggregating the DA
+// attestations of the last commit, to be included in a proposal.
+func (s *Service) BuildDAAttestationsTx(
+	req *cmtabci.PrepareProposalRequest,
+) ([]byte, error) {
+	bz, err := req.LocalLastCommit.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling extended commit: %w", err)
+	}
+	//#nosec: G115 // Unix time will never be negative.
+	return envelope.EncodeTx(bz, s.chainSpec.ConsensusTxCompression(math.U64(req.GetTime().Unix())))
+}
+
+// verifyDAAttestations ensures that the parent of the proposed block was
+// attested available by more than two thirds of the last commit voting power,
+// if vote extensions were enabled at its height.
+func (s *Service) verifyDAAttestations(
+	ctx sdk.Context,
+	req *cmtabci.ProcessProposalRequest,
+	blk *ctypes.BeaconBlock,
+	compressed bool,
+) error {
+	parentHeight := req.Height - 1
+	required := voteExtensionsEnabled(ctx, parentHeight) && len(req.ProposedLastCommit.Votes) > 0
+	hasTx := uint(len(req.Txs)) > DAAttestationsTxIndex
+	switch {
+	case !required && !hasTx:
+		return nil
+	case !required:
+		return ErrUnexpectedDAAttestations
+	case !hasTx:
+		return ErrMissingDAAttestations
+	}
+
+	bz, err := envelope.DecodeTx(req.Txs[DAAttestationsTxIndex], compressed, maxConsensusTxSize(ctx))
+	if err != nil {
+		return err
+	}
+	var extCommit cmtabci.ExtendedCommitInfo
+	if err = extCommit.Unmarshal(bz); err != nil {
+		return fmt.Errorf("%w: %w", attestation.ErrInvalidExtendedCommit, err)
+	}
+
+	st := s.storageBackend.StateFromContext(ctx)
+	attestedPower, err := attestation.VerifyExtendedCommit(
+		ctx.ChainID(),
+		parentHeight,
+		&extCommit,
+		&req.ProposedLastCommit,
+		blk.GetParentBlockRoot(),
+		func(cometBFTAddress []byte) (math.ValidatorIndex, crypto.BLSPubkey, error) {
+			return s.validatorAddresses.resolve(st, cometBFTAddress)
+		},
+	)
+	s.metrics.measureDAAttestations(attestedPower)
+	return err
+}
+
+// markBlockVerified records the root of a proposal whose block and blob
+// sidecars were verified, so that this node can attest its availability.
+func (s *Service) markBlockVerified(hash []byte, blockRoot common.Root) {
+	s.verifiedBlocksMu.Lock()
+	defer s.verifiedBlocksMu.Unlock()
+	s.verifiedBlocks[string(hash)] = blockRoot
+}
+
+// verifiedBlockRoot returns the root of the verified proposal with the given
+// CometBFT hash.
+func (s *Service) verifiedBlockRoot(hash []byte) (common.Root, bool) {
+	s.verifiedBlocksMu.RLock()
+	defer s.verifiedBlocksMu.RUnlock()
+	blockRoot, ok := s.verifiedBlocks[string(hash)]
+	return blockRoot, ok
+}
+
+// clearVerifiedBlocks forgets the verified proposals once a block is
+// finalized, as votes are only ever extended for the current height.
+func (s *Service) clearVerifiedBlocks() {
+	s.verifiedBlocksMu.Lock()
+	defer s.verifiedBlocksMu.Unlock()
+	clear(s.verifiedBlocks)
+}
+
+// voteExtensionsEnabled returns whether the CometBFT consensus params of ctx
+// enable vote extensions at the given height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	params := ctx.ConsensusParams()
+	enableHeight := params.GetFeature().GetVoteExtensionsEnableHeight().GetValue()
+	return enableHeight > 0 && height >= enableHeight
+}
+
+// maxConsensusTxSize returns the maximum decoded size of a consensus tx under
+// the CometBFT consensus params of ctx.
+func maxConsensusTxSize(ctx sdk.Context) int64 {
+	params := ctx.ConsensusParams()
+	return envelope.MaxTxSize(params.GetBlock().GetMaxBytes())
+}
+
+// validatorAddresses caches the CometBFT address of the registry validators.
+// The registry is append only, so the cache is only ever extended.
+type validatorAddresses struct {
+	mu        sync.Mutex
+	byAddress map[string]math.ValidatorIndex
+	pubkeys   []crypto.BLSPubkey
+}
+
+// newValidatorAddresses returns an empty validatorAddresses cache.
+func newValidatorAddresses() *validatorAddresses {
+	return &validatorAddresses{byAddress: make(map[string]math.ValidatorIndex)}
+}
+
+// resolve returns the index and public key of the validator with the given
+// CometBFT address, extending the cache with the validators added to the
+// registry of st since the last miss.
+func (va *validatorAddresses) resolve(
+	st *statedb.StateDB,
+	cometBFTAddress []byte,
+) (math.ValidatorIndex, crypto.BLSPubkey, error) {
+	va.mu.Lock()
+	defer va.mu.Unlock()
+	if index, ok := va.byAddress[string(cometBFTAddress)]; ok {
+		return index, va.pubkeys[index], nil
+	}
+
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return 0, crypto.BLSPubkey{}, fmt.Errorf("failed retrieving validators count: %w", err)
+	}
+	for index := math.ValidatorIndex(len(va.pubkeys)); index < total; index++ {
+		val, errVal := st.ValidatorByIndex(index)
+		if errVal != nil {
+			return 0, crypto.BLSPubkey{}, fmt.Errorf("failed retrieving validator %d: %w", index, errVal)
+		}
+		address, errAddr := crypto.GetAddressFromPubKey(val.GetPubkey())
+		if errAddr != nil {
+			return 0, crypto.BLSPubkey{}, errAddr
+		}
+		va.byAddress[string(address)] = index
+		va.pubkeys = append(va.pubkeys, val.GetPubkey())
+	}
+
+	index, ok := va.byAddress[string(cometBFTAddress)]
+	if !ok {
+		return 0, crypto.BLSPubkey{}, fmt.Errorf("%w: %X", ErrUnknownValidator, cometBFTAddress)
+	}
+	return index, va.pubkeys[index], nil
+}
@@ -22,17 +22,21 @@ package blockchain
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"slices"
-	"strconv"
 	"time"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
 // defaultRetryInterval processes a deposit event.
 const defaultRetryInterval = 20 * time.Second
 
+// depositFetcher fetches and stores the deposits of the EL block eth1FollowDistance
+// blocks behind the given one. It must be called with blockMu held.
 func (s *Service) depositFetcher(
 	ctx context.Context,
 	blockNum math.U64,
@@ -50,24 +54,34 @@ func (s *Service) depositFetcher(
 }
 
 // fetchAndStoreDeposits processes all deposits at a particular EL block height.
+// It must be called with blockMu held.
 // TODO: This could be optimized to process a contiguous range of blocks simultaneously to minimize EL RPC calls.
 func (s *Service) fetchAndStoreDeposits(
 	ctx context.Context,
 	blockNum math.U64,
 ) {
-	blockNumStr := strconv.FormatUint(blockNum.Unwrap(), 10)
+	deposits, ok := s.fetchDeposits(ctx, blockNum)
+	if ok {
+		s.storeDeposits(ctx, blockNum, deposits)
+	}
+}
+
+// fetchDeposits reads the deposits of a particular EL block height, marking the
+// block as failed if they cannot be read.
+func (s *Service) fetchDeposits(
+	ctx context.Context,
+	blockNum math.U64,
+) ([]*ctypes.Deposit, bool) {
 	deposits, err := s.depositContract.ReadDeposits(ctx, blockNum, blockNum)
 	if err != nil {
 		s.logger.Error("Failed to read deposits", "error", err)
 		s.metrics.sink.IncrementCounter(
 			"beacon_kit.execution.deposit.failed_to_get_block_logs",
-			"block_num",
-			blockNumStr,
 		)
 		s.failedBlocksMu.Lock()
 		s.failedBlocks[blockNum] = struct{}{}
 		s.failedBlocksMu.Unlock()
-		return
+		return nil, false
 	}
 
 	if len(deposits) > 0 {
@@ -76,13 +90,21 @@ func (s *Service) fetchAndStoreDeposits(
 			"block", blockNum, "deposits", len(deposits),
 		)
 	}
+	return deposits, true
+}
 
-	if err = s.storageBackend.DepositStore().EnqueueDeposits(ctx, deposits); err != nil {
+// storeDeposits stores the deposits of a particular EL block height, marking
+// the block as failed if they cannot be stored. It must be called with blockMu
+// held.
+func (s *Service) storeDeposits(
+	ctx context.Context,
+	blockNum math.U64,
+	deposits []*ctypes.Deposit,
+) {
+	if err := s.storageBackend.DepositStore().EnqueueDeposits(ctx, deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
 		s.metrics.sink.IncrementCounter(
 			"beacon_kit.execution.deposit.failed_to_enqueue_deposits",
-			"block_num",
-			blockNumStr,
 		)
 		s.failedBlocksMu.Lock()
 		s.failedBlocks[blockNum] = struct{}{}
@@ -94,6 +116,28 @@ func (s *Service) fetchAndStoreDeposits(
 	s.failedBlocksMu.Unlock()
 }
 
+// finalizeDeposits marks the deposits processed up to the given state as finalized in
+// the deposit store, so that its EIP-4881 snapshot follows the chain. Since any block
+// is final once committed, this happens right after the block is finalized.
+func (s *Service) finalizeDeposits(ctx context.Context, st *statedb.StateDB) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		s.logger.Error("Failed to get eth1 deposit index", "error", err)
+		return
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error("Failed to get latest execution payload header", "error", err)
+		return
+	}
+
+	if err = s.storageBackend.DepositStore().Finalize(
+		ctx, depositIndex, header.GetBlockHash(), header.GetNumber(),
+	); err != nil {
+		s.logger.Error("Failed to finalize deposits", "deposit_index", depositIndex, "error", err)
+	}
+}
+
 func (s *Service) depositCatchupFetcher(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
@@ -114,12 +158,59 @@ func (s *Service) depositCatchupFetcher(ctx context.Context) {
 				failedBlks,
 			)
 
-			// Fetch deposits for blocks that failed to be processed.
+			// Fetch deposits for blocks that failed to be processed. They are
+			// stored in between blocks, as for a re-fetch.
 			// TODO: This can be optimized to process all the blocks queried at once by utilizing log query ranges
 			// for contiguous ranges of blocks
 			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+				deposits, ok := s.fetchDeposits(ctx, blockNum)
+				if !ok {
+					continue
+				}
+				s.blockMu.Lock()
+				s.storeDeposits(ctx, blockNum, deposits)
+				s.blockMu.Unlock()
 			}
 		}
 	}
 }
+
+// FailedDepositBlocks returns the EL blocks whose deposits failed to be
+// fetched and are pending a retry, in ascending order.
+func (s *Service) FailedDepositBlocks() []math.U64 {
+	s.failedBlocksMu.RLock()
+	defer s.failedBlocksMu.RUnlock()
+	return slices.Sorted(maps.Keys(s.failedBlocks))
+}
+
+// RefetchDeposits fetches and stores the deposits of the EL blocks in the
+// inclusive range [from, to], regardless of whether they were already
+// processed. It returns the number of deposits found in the range.
+// Deposits are stored in between blocks, so that the deposits read by a block
+// are not changed while it is built or processed.
+func (s *Service) RefetchDeposits(ctx context.Context, from, to math.U64) (int, error) {
+	deposits, err := s.depositContract.ReadDeposits(ctx, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read deposits of blocks %d-%d: %w", from, to, err)
+	}
+	// Deposits already in the store are overwritten and their leaf in the
+	// deposit tree replaced. Finalized deposits must match the stored ones.
+	s.blockMu.Lock()
+	err = s.storageBackend.DepositStore().EnqueueDeposits(ctx, deposits)
+	s.blockMu.Unlock()
+	if err != nil {
+		return 0, fmt.Errorf("failed to store deposits of blocks %d-%d: %w", from, to, err)
+	}
+
+	s.failedBlocksMu.Lock()
+	maps.DeleteFunc(s.failedBlocks, func(blockNum math.U64, _ struct{}) bool {
+		return blockNum >= from && blockNum <= to
+	})
+	s.failedBlocksMu.Unlock()
+
+	s.logger.Info(
+		"Re-fetched deposits from execution layer",
+		"from", from, "to", to, "deposits", len(deposits),
+	)
+	return len(deposits), nil
+}
@@ -40,4 +40,11 @@ var (
 	ErrSidecarCommitmentMismatch = errors.New("sidecars commitments mismatch")
 	// ErrSidecarSignatureMismatch indicates that the sidecar signature is invalid.
 	ErrSidecarSignatureMismatch = errors.New("sidecar signature mismatch")
+	// ErrMissingDAAttestations indicates that a proposal lacks the DA attestations of its parent.
+	ErrMissingDAAttestations = errors.New("missing DA attestations")
+	// ErrUnexpectedDAAttestations indicates that a proposal includes DA attestations
+	// while vote extensions are disabled.
+	ErrUnexpectedDAAttestations = errors.New("unexpected DA attestations")
+	// ErrUnknownValidator indicates that no validator of the registry has the CometBFT address.
+	ErrUnknownValidator = errors.New("unknown validator")
 )
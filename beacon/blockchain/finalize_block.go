@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -38,8 +39,12 @@ func (s *Service) FinalizeBlock(
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (transition.ValidatorUpdates, error) {
+	s.blockMu.Lock()
+	defer s.blockMu.Unlock()
+
 	// STEP 1: Decode block and blobs.
-	currentForkVersion := s.chainSpec.ActiveForkVersionForTimestamp(math.U64(req.GetTime().Unix())) //#nosec: G115
+	timestamp := math.U64(req.GetTime().Unix()) //#nosec: G115
+	currentForkVersion := s.chainSpec.ActiveForkVersionForTimestamp(timestamp)
 	signedBlk, blobs, err := encoding.ExtractBlobsAndBlockFromRequest(
 		req,
 		BeaconBlockTxIndex,
@@ -47,6 +52,8 @@ func (s *Service) FinalizeBlock(
 		// While req.GetTime() and blk.GetTimestamp() may be different, they are guaranteed
 		// to map to the same forkVersion due to checks during ProcessProposal.
 		currentForkVersion,
+		s.chainSpec.ConsensusTxCompression(timestamp),
+		maxConsensusTxSize(ctx),
 	)
 	if err != nil {
 		s.logger.Error("Failed to decode block and blobs", "error", err)
@@ -54,7 +61,7 @@ func (s *Service) FinalizeBlock(
 	}
 	s.logger.Debug(
 		"Finalizing block with fork version",
-		"block", req.Height,
+		log.KeyHeight, req.Height,
 		"fork", currentForkVersion.String(),
 	)
 	blk := signedBlk.GetBeaconBlock()
@@ -106,12 +113,15 @@ func (s *Service) FinalizeBlock(
 		)
 		return nil, err
 	}
+	s.validatorMonitor.OnFinalizeBlock(st, consensusBlk)
 
 	// STEP 4: Post Finalizations cleanups.
+	s.clearVerifiedBlocks()
 
 	// Fetch and store the deposit for the block.
 	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
 	s.depositFetcher(ctx, blockNum)
+	s.finalizeDeposits(ctx, st)
 
 	// Store the finalized block in the KVStore.
 	//
@@ -22,10 +22,12 @@ package blockchain
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/berachain/beacon-kit/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -58,6 +60,13 @@ type ExecutionEngine interface {
 	) (*engineprimitives.PayloadID, error)
 }
 
+// ValidatorMonitor observes the blocks finalized by the service.
+type ValidatorMonitor interface {
+	// OnFinalizeBlock is called with each finalized block and its
+	// post-state.
+	OnFinalizeBlock(st *statedb.StateDB, blk *types.ConsensusBlock)
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder interface {
 	// Enabled returns true if the local builder is enabled.
@@ -129,6 +138,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// SetGauge sets the gauge identified by the provided key to value.
+	SetGauge(key string, value int64, args ...string)
 }
 
 //nolint:revive // its ok
@@ -143,6 +155,18 @@ type BlockchainI interface {
 		sdk.Context,
 		*cmtabci.FinalizeBlockRequest,
 	) (transition.ValidatorUpdates, error)
+	ExtendVote(
+		sdk.Context,
+		*cmtabci.ExtendVoteRequest,
+		[]byte,
+	) ([]byte, error)
+	VerifyVoteExtension(
+		sdk.Context,
+		*cmtabci.VerifyVoteExtensionRequest,
+	) error
+	BuildDAAttestationsTx(*cmtabci.PrepareProposalRequest) ([]byte, error)
+	BlockLocker() sync.Locker
+	FlushStores() error
 }
 
 // BlobProcessor is the interface for the blobs processor.
@@ -174,4 +198,5 @@ type ServiceChainSpec interface {
 	chain.ForkVersionSpec
 
 	Eth1FollowDistance() uint64
+	ConsensusTxCompression(timestamp math.U64) bool
 }
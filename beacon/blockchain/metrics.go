@@ -22,8 +22,6 @@ package blockchain
 
 import (
 	"time"
-
-	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // chainMetrics is a struct that contains metrics for the chain.
@@ -52,59 +50,48 @@ func (cm *chainMetrics) measureStateTransitionDuration(
 	)
 }
 
+// measureDAAttestations records the voting power of the last commit which
+// attested the availability of the parent of a proposal.
+func (cm *chainMetrics) measureDAAttestations(attestedPower int64) {
+	cm.sink.SetGauge(
+		"beacon_kit.blockchain.da_attested_voting_power",
+		attestedPower,
+	)
+}
+
 // markRebuildPayloadForRejectedBlockSuccess increments the counter for the
 // number of times
 // the validator successfully rebuilt the payload for a rejected block.
-func (cm *chainMetrics) markRebuildPayloadForRejectedBlockSuccess(
-	slot math.Slot,
-) {
+func (cm *chainMetrics) markRebuildPayloadForRejectedBlockSuccess() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.rebuild_payload_for_rejected_block_success",
-		"slot",
-		slot.Base10(),
 	)
 }
 
 // markRebuildPayloadForRejectedBlockFailure increments the counter for the
 // number of times
 // the validator failed to build an optimistic payload due to a failure.
-func (cm *chainMetrics) markRebuildPayloadForRejectedBlockFailure(
-	slot math.Slot,
-	err error,
-) {
+func (cm *chainMetrics) markRebuildPayloadForRejectedBlockFailure() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.rebuild_payload_for_rejected_block_failure",
-		"slot",
-		slot.Base10(),
-		"error",
-		err.Error(),
 	)
 }
 
 // markOptimisticPayloadBuildSuccess increments the counter for the number of
 // times
 // the validator successfully built an optimistic payload.
-func (cm *chainMetrics) markOptimisticPayloadBuildSuccess(slot math.Slot) {
+func (cm *chainMetrics) markOptimisticPayloadBuildSuccess() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.optimistic_payload_build_success",
-		"slot",
-		slot.Base10(),
 	)
 }
 
 // markOptimisticPayloadBuildFailure increments the counter for the number of
 // times
 // the validator failed to build an optimistic payload.
-func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
-	slot math.Slot,
-	err error,
-) {
+func (cm *chainMetrics) markOptimisticPayloadBuildFailure() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.optimistic_payload_build_failure",
-		"slot",
-		slot.Base10(),
-		"error",
-		err.Error(),
 	)
 }
 
@@ -208,10 +208,10 @@ func (s *Service) rebuildPayloadForRejectedBlock(
 		// and possibly should be made more explicit later on.
 		lph.GetParentHash(),
 	); err != nil {
-		s.metrics.markRebuildPayloadForRejectedBlockFailure(stateSlot, err)
+		s.metrics.markRebuildPayloadForRejectedBlockFailure()
 		return err
 	}
-	s.metrics.markRebuildPayloadForRejectedBlockSuccess(stateSlot)
+	s.metrics.markRebuildPayloadForRejectedBlockSuccess()
 	return nil
 }
 
@@ -283,9 +283,9 @@ func (s *Service) optimisticPayloadBuild(
 		// just processed.
 		payload.GetParentHash(),
 	); err != nil {
-		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
+		s.metrics.markOptimisticPayloadBuildFailure()
 		return err
 	}
-	s.metrics.markOptimisticPayloadBuildSuccess(slot)
+	s.metrics.markOptimisticPayloadBuildSuccess()
 	return nil
 }
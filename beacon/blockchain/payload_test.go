@@ -302,6 +302,7 @@ func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec, optimisticPayloadB
 		b,
 		sp,
 		ts,
+		nil, // blockchain.ValidatorMonitor unused in this test
 		optimisticPayloadBuilds,
 	)
 	return chain, st, cms, ctx, sp, b, sb, eng, depStore
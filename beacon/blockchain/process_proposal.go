@@ -51,9 +51,13 @@ const (
 	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
 	// It follows the beacon block transaction in the tx list.
 	BlobSidecarsTxIndex
+	// DAAttestationsTxIndex represents the index of the DA attestations
+	// transaction. It is only included once vote extensions are enabled.
+	DAAttestationsTxIndex
 
-	// A Consensus block has at most two transactions (block and blob).
-	MaxConsensusTxsCount = 2
+	// A Consensus block has at most three transactions (block, blob and DA
+	// attestations).
+	MaxConsensusTxsCount = 3
 )
 
 //nolint:funlen // not an issue
@@ -61,6 +65,9 @@ func (s *Service) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) error {
+	s.blockMu.Lock()
+	defer s.blockMu.Unlock()
+
 	if countTx := len(req.Txs); countTx > MaxConsensusTxsCount {
 		return fmt.Errorf("max expected %d, got %d: %w",
 			MaxConsensusTxsCount, countTx,
@@ -68,13 +75,17 @@ func (s *Service) ProcessProposal(
 		)
 	}
 
-	forkVersion := s.chainSpec.ActiveForkVersionForTimestamp(math.U64(req.GetTime().Unix())) //#nosec: G115
+	timestamp := math.U64(req.GetTime().Unix()) //#nosec: G115
+	forkVersion := s.chainSpec.ActiveForkVersionForTimestamp(timestamp)
+	compressed := s.chainSpec.ConsensusTxCompression(timestamp)
 	// Decode signed block and sidecars.
 	signedBlk, sidecars, err := encoding.ExtractBlobsAndBlockFromRequest(
 		req,
 		BeaconBlockTxIndex,
 		BlobSidecarsTxIndex,
 		forkVersion,
+		compressed,
+		maxConsensusTxSize(ctx),
 	)
 	if err != nil {
 		return err
@@ -115,6 +126,11 @@ func (s *Service) ProcessProposal(
 		)
 	}
 
+	// Make sure the parent block was attested available, if required.
+	if err = s.verifyDAAttestations(ctx, req, blk, compressed); err != nil {
+		return err
+	}
+
 	// Make sure we have the right number of BlobSidecars
 	blobKzgCommitments := blk.GetBody().GetBlobKzgCommitments()
 	numCommitments := len(blobKzgCommitments)
@@ -178,6 +194,7 @@ func (s *Service) ProcessProposal(
 		return err
 	}
 
+	s.markBlockVerified(req.Hash, blk.HashTreeRoot())
 	return nil
 }
 
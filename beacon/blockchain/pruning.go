@@ -24,6 +24,7 @@ import (
 	"context"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 func (s *Service) processPruning(ctx context.Context, beaconBlk *ctypes.BeaconBlock) error {
@@ -44,6 +45,17 @@ func (s *Service) processPruning(ctx context.Context, beaconBlk *ctypes.BeaconBl
 	return nil
 }
 
+// PruneBlobs prunes the blob sidecars that fall out of the availability
+// window as of the given head slot. It returns the slot up to which, exclusive,
+// the sidecars have been pruned.
+func (s *Service) PruneBlobs(headSlot math.Slot) (math.Slot, error) {
+	start, end := availabilityPruneRangeFn(headSlot.Unwrap(), s.chainSpec)
+	if err := s.storageBackend.AvailabilityStore().Prune(start, end); err != nil {
+		return 0, err
+	}
+	return math.Slot(end), nil
+}
+
 func depositPruneRangeFn([]*ctypes.Deposit, PruningChainSpec) (uint64, uint64) {
 	// The whole deposit list is validated in consensus and its Merkle root is part of
 	// Beacon State. Therefore, every node must keep the full deposit list and deposits
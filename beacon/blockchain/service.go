@@ -22,10 +22,12 @@ package blockchain
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -40,11 +42,22 @@ type Service struct {
 	depositContract deposit.Contract
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
+	// blockMu serializes block building and processing with the operations
+	// changing their inputs out of band, e.g. re-fetching deposits on
+	// operator request or retrying failed deposit fetches.
+	blockMu sync.Mutex
 	// failedBlocksMu protects failedBlocks for concurrent access.
 	failedBlocksMu sync.RWMutex
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// verifiedBlocksMu protects verifiedBlocks for concurrent access.
+	verifiedBlocksMu sync.RWMutex
+	// verifiedBlocks maps the CometBFT hash of the proposals verified at the
+	// current height to their beacon block root, for DA attestations.
+	verifiedBlocks map[string]common.Root
+	// validatorAddresses resolves the validators voting in CometBFT.
+	validatorAddresses *validatorAddresses
 	// logger is used for logging messages in the service.
 	logger log.Logger
 	// chainSpec holds the chain specifications.
@@ -59,6 +72,8 @@ type Service struct {
 	stateProcessor StateProcessor
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
+	// validatorMonitor observes the finalized blocks.
+	validatorMonitor ValidatorMonitor
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
@@ -77,6 +92,7 @@ func NewService(
 	localBuilder LocalBuilder,
 	stateProcessor StateProcessor,
 	telemetrySink TelemetrySink,
+	validatorMonitor ValidatorMonitor,
 	optimisticPayloadBuilds bool,
 ) *Service {
 	return &Service{
@@ -85,12 +101,15 @@ func NewService(
 		depositContract:         depositContract,
 		eth1FollowDistance:      math.U64(chainSpec.Eth1FollowDistance()),
 		failedBlocks:            make(map[math.Slot]struct{}),
+		verifiedBlocks:          make(map[string]common.Root),
+		validatorAddresses:      newValidatorAddresses(),
 		logger:                  logger,
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
+		validatorMonitor:        validatorMonitor,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
 	}
@@ -115,12 +134,30 @@ func (s *Service) Stop() error {
 
 	err := s.storageBackend.DepositStore().Close()
 	if err != nil {
-		s.logger.Error("failed to close deposit store", "err", err)
+		s.logger.Error("failed to close deposit store", log.KeyError, err)
 	}
 
 	return nil
 }
 
+// FlushStores commits the data of the deposit and blob stores to stable
+// storage, e.g. before the node halts.
+func (s *Service) FlushStores() error {
+	if err := s.storageBackend.DepositStore().Sync(); err != nil {
+		return fmt.Errorf("failed to sync deposits: %w", err)
+	}
+	if err := s.storageBackend.AvailabilityStore().Sync(); err != nil {
+		return fmt.Errorf("failed to sync blob sidecars: %w", err)
+	}
+	return nil
+}
+
+// BlockLocker returns the lock held while blocks are processed. Block builders
+// hold it too, so that deposits are not changed while a block is built.
+func (s *Service) BlockLocker() sync.Locker {
+	return &s.blockMu
+}
+
 // StorageBackend returns the storage backend.
 func (s *Service) StorageBackend() StorageBackend {
 	return s.storageBackend
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"bytes"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/light"
+)
+
+// TrustOptions are the parameters of the light client verification.
+type TrustOptions struct {
+	// ChainID is the CometBFT chain ID.
+	ChainID string
+	// Period is the trusting period, it should be significantly less than
+	// the unbonding period.
+	Period time.Duration
+	// Level is the fraction of the trusted validator set voting power which
+	// must have signed a non adjacent update for it to be trusted.
+	Level cmtmath.Fraction
+	// MaxClockDrift is the maximum allowed drift of the update header times.
+	MaxClockDrift time.Duration
+}
+
+// Checkpoint is the trusted starting point of the light client, obtained
+// from a trusted source. Both roots are needed since the beacon block root
+// does not commit to the CometBFT validator set.
+type Checkpoint struct {
+	// BlockRoot is the root of the trusted beacon block.
+	BlockRoot common.Root
+	// HeaderHash is the hash of the trusted CometBFT header.
+	HeaderHash []byte
+}
+
+// Client verifies light blocks, starting from a trusted checkpoint, and
+// keeps track of the latest trusted one.
+//
+// Client is not safe for concurrent use.
+type Client struct {
+	spec    ForkSpec
+	opts    TrustOptions
+	trusted *LightBlock
+}
+
+// NewClient creates a light client from the bootstrap light block, which
+// must match the trusted checkpoint.
+func NewClient(
+	spec ForkSpec,
+	opts TrustOptions,
+	checkpoint Checkpoint,
+	bootstrap *LightBlock,
+) (*Client, error) {
+	if err := bootstrap.ValidateBasic(spec, opts.ChainID); err != nil {
+		return nil, err
+	}
+	if root := bootstrap.Header.HashTreeRoot(); root != checkpoint.BlockRoot {
+		return nil, errors.Wrapf(ErrUntrustedBootstrap,
+			"block root %s, trusted %s", root, checkpoint.BlockRoot,
+		)
+	}
+	if hash := bootstrap.CometBFT.Hash(); !bytes.Equal(hash, checkpoint.HeaderHash) {
+		return nil, errors.Wrapf(ErrUntrustedBootstrap,
+			"header hash %X, trusted %X", hash, checkpoint.HeaderHash,
+		)
+	}
+	return &Client{
+		spec:    spec,
+		opts:    opts,
+		trusted: bootstrap,
+	}, nil
+}
+
+// Update verifies the given light block against the trusted one and, if
+// valid, makes it the trusted light block. Adjacent light blocks are verified
+// against the trusted next validator set, non adjacent ones must be signed by
+// at least the trust level of the trusted validator set.
+func (c *Client) Update(update *LightBlock, now time.Time) error {
+	if err := update.ValidateBasic(c.spec, c.opts.ChainID); err != nil {
+		return err
+	}
+	if update.Height() <= c.trusted.Height() {
+		return errors.Wrapf(ErrNonIncreasingHeight,
+			"update height %d, trusted height %d", update.Height(), c.trusted.Height(),
+		)
+	}
+	if err := light.Verify(
+		c.trusted.CometBFT.SignedHeader,
+		c.trusted.CometBFT.ValidatorSet,
+		update.CometBFT.SignedHeader,
+		update.CometBFT.ValidatorSet,
+		c.opts.Period,
+		now,
+		c.opts.MaxClockDrift,
+		c.opts.Level,
+	); err != nil {
+		return err
+	}
+	c.trusted = update
+	return nil
+}
+
+// Trusted returns the latest trusted light block.
+func (c *Client) Trusted() *LightBlock {
+	return c.trusted
+}
+
+// Header returns the latest trusted beacon block header.
+func (c *Client) Header() *ctypes.BeaconBlockHeader {
+	return c.trusted.Header
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package lightclient_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/testing/utils"
+	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "light-client-test"
+
+// makeLightBlock builds a light block at the given height, whose commit is
+// signed by all the validators.
+func makeLightBlock(
+	t *testing.T,
+	cs chain.Spec,
+	height int64,
+	blockTime time.Time,
+	vals *cmttypes.ValidatorSet,
+	privVals []cmttypes.PrivValidator,
+	nextVals *cmttypes.ValidatorSet,
+) *lightclient.LightBlock {
+	t.Helper()
+
+	//#nosec: G115 // test values.
+	forkVersion := cs.ActiveForkVersionForTimestamp(math.U64(blockTime.Unix()))
+	blk := utils.GenerateValidBeaconBlock(t, forkVersion)
+	blk.Slot = math.Slot(height) //#nosec: G115 // test values.
+	blkBz, err := (&ctypes.SignedBeaconBlock{BeaconBlock: blk}).MarshalSSZ()
+	require.NoError(t, err)
+	txs := cmttypes.Txs{blkBz, []byte("blob sidecars")}
+
+	header := &cmttypes.Header{}
+	header.Populate(
+		cmtversion.Consensus{Block: version.BlockProtocol}, testChainID,
+		blockTime, cmttypes.BlockID{},
+		vals.Hash(), nextVals.Hash(),
+		make([]byte, 32), make([]byte, 32), make([]byte, 32),
+		vals.Proposer.Address,
+	)
+	header.Height = height
+	header.DataHash = txs.Hash()
+	header.LastCommitHash = make([]byte, 32)
+	header.EvidenceHash = make([]byte, 32)
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+	}
+	voteSet := cmttypes.NewVoteSet(testChainID, height, 0, cmttypes.PrecommitType, vals)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, height, 0, voteSet, privVals, blockTime, false)
+	require.NoError(t, err)
+
+	proof := txs.Proof(lightclient.BeaconBlockTxIndex)
+	return &lightclient.LightBlock{
+		Header: blk.GetHeader(),
+		CometBFT: &cmttypes.LightBlock{
+			SignedHeader: &cmttypes.SignedHeader{Header: header, Commit: extCommit.ToCommit()},
+			ValidatorSet: vals,
+		},
+		BlockProof: &proof,
+	}
+}
+
+func testTrustOptions() lightclient.TrustOptions {
+	return lightclient.TrustOptions{
+		ChainID:       testChainID,
+		Period:        time.Hour,
+		Level:         cmtmath.Fraction{Numerator: 1, Denominator: 3},
+		MaxClockDrift: 10 * time.Second,
+	}
+}
+
+func TestClientUpdates(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	vals, privVals := cmttypes.RandValidatorSet(4, 10)
+	start := time.Now().Add(-time.Minute)
+	bootstrap := makeLightBlock(t, cs, 10, start, vals, privVals, vals)
+
+	client, err := lightclient.NewClient(cs, testTrustOptions(), lightclient.Checkpoint{
+		BlockRoot:  bootstrap.Header.HashTreeRoot(),
+		HeaderHash: bootstrap.CometBFT.Hash(),
+	}, bootstrap)
+	require.NoError(t, err)
+
+	// Adjacent update.
+	update := makeLightBlock(t, cs, 11, start.Add(time.Second), vals, privVals, vals)
+	require.NoError(t, client.Update(update, time.Now()))
+	require.Equal(t, update.Header, client.Header())
+
+	// Non adjacent update, going through its JSON encoding.
+	update = makeLightBlock(t, cs, 20, start.Add(10*time.Second), vals, privVals, vals)
+	bz, err := json.Marshal(update)
+	require.NoError(t, err)
+	decoded := &lightclient.LightBlock{}
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.NoError(t, client.Update(decoded, time.Now()))
+	require.Equal(t, update.Header.HashTreeRoot(), client.Header().HashTreeRoot())
+	require.Equal(t, int64(20), client.Trusted().Height())
+
+	// Stale updates are rejected.
+	err = client.Update(update, time.Now())
+	require.ErrorIs(t, err, lightclient.ErrNonIncreasingHeight)
+
+	// Updates signed by an unknown validator set are rejected.
+	otherVals, otherPrivVals := cmttypes.RandValidatorSet(4, 10)
+	update = makeLightBlock(t, cs, 21, start.Add(11*time.Second), otherVals, otherPrivVals, otherVals)
+	require.Error(t, client.Update(update, time.Now()))
+	require.Equal(t, int64(20), client.Trusted().Height())
+}
+
+func TestLightBlockValidation(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	vals, privVals := cmttypes.RandValidatorSet(4, 10)
+	lb := makeLightBlock(t, cs, 10, time.Now(), vals, privVals, vals)
+	require.NoError(t, lb.ValidateBasic(cs, testChainID))
+
+	// The checkpoint must match the bootstrap.
+	_, err = lightclient.NewClient(cs, testTrustOptions(), lightclient.Checkpoint{
+		BlockRoot:  lb.Header.HashTreeRoot(),
+		HeaderHash: make([]byte, 32),
+	}, lb)
+	require.ErrorIs(t, err, lightclient.ErrUntrustedBootstrap)
+
+	// The beacon block header must match the included beacon block.
+	tampered := *lb
+	header := *lb.Header
+	header.ProposerIndex++
+	tampered.Header = &header
+	require.ErrorIs(t, tampered.ValidateBasic(cs, testChainID), lightclient.ErrHeaderMismatch)
+
+	// The proof must be the one of the beacon block transaction.
+	tampered = *lb
+	proof := *lb.BlockProof
+	proof.Proof.Index = 1
+	tampered.BlockProof = &proof
+	require.ErrorIs(t, tampered.ValidateBasic(cs, testChainID), lightclient.ErrInvalidBlockProof)
+}
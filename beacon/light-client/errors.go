@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrMissingData is returned when a light block misses one of its parts.
+	ErrMissingData = errors.New("light block is missing data")
+
+	// ErrInvalidBlockProof is returned when the beacon block is not proven to
+	// be the beacon block transaction of the CometBFT block.
+	ErrInvalidBlockProof = errors.New("invalid beacon block inclusion proof")
+
+	// ErrHeaderMismatch is returned when the beacon block header does not match
+	// the beacon block included in the CometBFT block.
+	ErrHeaderMismatch = errors.New("beacon block header mismatch")
+
+	// ErrUntrustedBootstrap is returned when the bootstrap light block does
+	// not match the trusted checkpoint.
+	ErrUntrustedBootstrap = errors.New("bootstrap does not match trusted checkpoint")
+
+	// ErrNonIncreasingHeight is returned when an update is not ahead of the
+	// trusted light block.
+	ErrNonIncreasingHeight = errors.New("update height not greater than trusted height")
+)
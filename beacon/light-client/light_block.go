@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package lightclient implements the light client protocol of beacon-kit.
+//
+// Beacon-kit has no sync committees: every beacon block is included as the first
+// transaction of a CometBFT block, which is final once committed. A light block
+// thus binds a beacon block header to the CometBFT light block (signed header and
+// validator set) including it, through a Merkle proof of the beacon block
+// transaction against the data hash of the CometBFT header. Verifying the commit
+// signatures of the CometBFT validators over that header is enough to trust the
+// beacon block header.
+package lightclient
+
+import (
+	"encoding/json"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// BeaconBlockTxIndex is the index of the beacon block transaction in the
+// CometBFT block.
+const BeaconBlockTxIndex = 0
+
+// ForkSpec returns the fork version and consensus tx envelope active at a
+// given timestamp. It is used to decode the beacon block with the rules of its
+// CometBFT block time.
+type ForkSpec interface {
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	ConsensusTxCompression(timestamp math.U64) bool
+}
+
+// LightBlock is a beacon block header along with the CometBFT light block
+// which includes the corresponding beacon block.
+type LightBlock struct {
+	// Header is the beacon block header.
+	Header *ctypes.BeaconBlockHeader
+	// CometBFT is the signed header and validator set of the CometBFT block.
+	CometBFT *cmttypes.LightBlock
+	// BlockProof proves that the SSZ encoded signed beacon block is the
+	// beacon block transaction of the CometBFT block.
+	BlockProof *cmttypes.TxProof
+}
+
+// lightBlockJSON is the JSON representation of a LightBlock. CometBFT types
+// are encoded with the CometBFT JSON encoding, which supports their keys.
+type lightBlockJSON struct {
+	Header     *ctypes.BeaconBlockHeader `json:"header"`
+	CometBFT   json.RawMessage           `json:"cometbft"`
+	BlockProof json.RawMessage           `json:"block_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (lb *LightBlock) MarshalJSON() ([]byte, error) {
+	cmtBz, err := cmtjson.Marshal(lb.CometBFT)
+	if err != nil {
+		return nil, err
+	}
+	proofBz, err := cmtjson.Marshal(lb.BlockProof)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(lightBlockJSON{
+		Header:     lb.Header,
+		CometBFT:   cmtBz,
+		BlockProof: proofBz,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (lb *LightBlock) UnmarshalJSON(bz []byte) error {
+	var raw lightBlockJSON
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	var (
+		cmtBlock cmttypes.LightBlock
+		proof    cmttypes.TxProof
+	)
+	if err := cmtjson.Unmarshal(raw.CometBFT, &cmtBlock); err != nil {
+		return errors.Wrap(err, "failed decoding CometBFT light block")
+	}
+	if err := cmtjson.Unmarshal(raw.BlockProof, &proof); err != nil {
+		return errors.Wrap(err, "failed decoding beacon block proof")
+	}
+	lb.Header = raw.Header
+	lb.CometBFT = &cmtBlock
+	lb.BlockProof = &proof
+	return nil
+}
+
+// Height returns the height of the light block.
+func (lb *LightBlock) Height() int64 {
+	return lb.CometBFT.Height
+}
+
+// ValidateBasic verifies that the light block is self consistent, without
+// verifying the commit signatures:
+//   - the CometBFT signed header and validator set are consistent,
+//   - the beacon block is the beacon block transaction of the CometBFT block,
+//   - the beacon block header matches the beacon block.
+func (lb *LightBlock) ValidateBasic(spec ForkSpec, chainID string) error {
+	if lb.Header == nil || lb.CometBFT == nil || lb.CometBFT.SignedHeader == nil || lb.BlockProof == nil {
+		return ErrMissingData
+	}
+	if err := lb.CometBFT.ValidateBasic(chainID); err != nil {
+		return err
+	}
+
+	// Ensure that the proven transaction is the beacon block one.
+	if lb.BlockProof.Proof.Index != BeaconBlockTxIndex {
+		return errors.Wrapf(ErrInvalidBlockProof,
+			"proof for tx %d, expected %d", lb.BlockProof.Proof.Index, BeaconBlockTxIndex,
+		)
+	}
+	if err := lb.BlockProof.Validate(lb.CometBFT.DataHash); err != nil {
+		return errors.Wrapf(ErrInvalidBlockProof, "%v", err)
+	}
+
+	//#nosec: G115 // Unix time will never be negative.
+	timestamp := math.U64(lb.CometBFT.Time.Unix())
+	signedBlk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(
+		spec.ActiveForkVersionForTimestamp(timestamp),
+	)
+	if err != nil {
+		return err
+	}
+	data, err := envelope.DecodeTx(
+		lb.BlockProof.Data, spec.ConsensusTxCompression(timestamp), envelope.MaxDecodedTxSize,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed decoding beacon block envelope")
+	}
+	if err = ssz.Unmarshal(data, signedBlk); err != nil {
+		return errors.Wrap(err, "failed decoding beacon block")
+	}
+	if blkRoot := signedBlk.GetBeaconBlock().HashTreeRoot(); blkRoot != lb.Header.HashTreeRoot() {
+		return errors.Wrapf(ErrHeaderMismatch,
+			"header root %s, block root %s", lb.Header.HashTreeRoot(), blkRoot,
+		)
+	}
+	if lb.Header.GetSlot().Unwrap() != uint64(lb.Height()) { //#nosec: G115 // heights are positive.
+		return errors.Wrapf(ErrHeaderMismatch,
+			"header slot %d, CometBFT height %d", lb.Header.GetSlot(), lb.Height(),
+		)
+	}
+	return nil
+}
@@ -32,12 +32,13 @@ import (
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
-	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
 )
 
 // BuildBlockAndSidecars builds a new beacon block.
@@ -155,7 +156,29 @@ func (s *Service) BuildBlockAndSidecars(
 		return nil, nil, scErr
 	}
 
-	return signedBlkBytes, sidecarsBytes, nil
+	// Wrap the transactions in the compressed envelope if enabled for the
+	// block, matching the consensus time used to decode them.
+	compress := s.chainSpec.ConsensusTxCompression(slotData.GetConsensusTime())
+	return s.encodeTxs(signedBlkBytes, sidecarsBytes, compress)
+}
+
+// encodeTxs wraps the beacon block and blob sidecars transactions in the
+// compressed envelope if compress is set.
+func (s *Service) encodeTxs(blkBz, sidecarsBz []byte, compress bool) ([]byte, []byte, error) {
+	if !compress {
+		return blkBz, sidecarsBz, nil
+	}
+	encodedBlkBz, err := envelope.EncodeTx(blkBz, compress)
+	if err != nil {
+		return nil, nil, err
+	}
+	encodedSidecarsBz, err := envelope.EncodeTx(sidecarsBz, compress)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.metrics.measureTxCompression("beacon_block", len(blkBz), len(encodedBlkBz))
+	s.metrics.measureTxCompression("blob_sidecars", len(sidecarsBz), len(encodedSidecarsBz))
+	return encodedBlkBz, encodedSidecarsBz, nil
 }
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
@@ -231,7 +254,7 @@ func (s *Service) retrieveExecutionPayload(
 	// call that needs to be called before requesting the Payload.
 	// TODO: We should decouple the PayloadBuilder from BeaconState to make
 	// this less confusing.
-	s.metrics.failedToRetrievePayload(slot, err)
+	s.metrics.failedToRetrievePayload()
 
 	// The latest execution payload header will be from the previous block
 	// during the block building phase.
@@ -297,29 +320,29 @@ func (s *Service) buildBlockBody(
 		return fmt.Errorf("failed loading eth1 deposit index: %w", err)
 	}
 
-	// Grab all previous deposits from genesis up to the current index + max deposits per block.
+	// Grab the deposits from the current index up to max deposits per block, along with
+	// the deposit root over all the deposits up to the last one included.
 	deposits, localDepositRoot, err := s.sb.DepositStore().GetDepositsByIndex(
 		ctx,
-		constants.FirstDepositIndex,
-		depositIndex+s.chainSpec.MaxDepositsPerBlock(),
+		depositIndex,
+		s.chainSpec.MaxDepositsPerBlock(),
 	)
-	if err != nil {
-		return err
-	}
-	if uint64(len(deposits)) < depositIndex {
+	if errors.Is(err, storage.ErrDepositsUnavailable) {
 		return errors.Wrapf(ErrDepositStoreIncomplete,
-			"all historical deposits not available, expected: %d, got: %d",
-			depositIndex, len(deposits),
+			"all historical deposits not available, start index: %d: %v", depositIndex, err,
 		)
 	}
+	if err != nil {
+		return err
+	}
 	s.logger.Info(
 		"Building block body with local deposits",
-		"start_index", depositIndex, "num_deposits", uint64(len(deposits))-depositIndex,
+		"start_index", depositIndex, "num_deposits", len(deposits),
 	)
 
 	eth1Data := ctypes.NewEth1Data(localDepositRoot)
 	body.SetEth1Data(eth1Data)
-	body.SetDeposits(deposits[depositIndex:])
+	body.SetDeposits(deposits)
 
 	// Set the graffiti on the block body.
 	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
@@ -104,6 +104,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
 
 type BlockBuilderI interface {
@@ -120,5 +123,6 @@ type ChainSpec interface {
 	MaxDepositsPerBlock() uint64
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	SlotToEpoch(slot math.Slot) math.Epoch
+	ConsensusTxCompression(timestamp math.U64) bool
 	ctypes.ProposerDomain
 }
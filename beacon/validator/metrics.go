@@ -22,8 +22,6 @@ package validator
 
 import (
 	"time"
-
-	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // validatorMetrics is a struct that contains metrics for the chain.
@@ -63,14 +61,22 @@ func (cm *validatorMetrics) measureStateRootComputationTime(start time.Time) {
 
 // failedToRetrievePayload increments the counter for the number of
 // times the validator failed to retrieve payloads.
-func (cm *validatorMetrics) failedToRetrievePayload(
-	slot math.Slot, err error,
-) {
-	cm.sink.IncrementCounter(
-		"beacon_kit.validator.failed_to_retrieve_payload",
-		"slot",
-		slot.Base10(),
-		"error",
-		err.Error(),
+func (cm *validatorMetrics) failedToRetrievePayload() {
+	cm.sink.IncrementCounter("beacon_kit.validator.failed_to_retrieve_payload")
+}
+
+// measureTxCompression records the encoded size of a consensus transaction
+// and its compression ratio, in percent of the raw size.
+func (cm *validatorMetrics) measureTxCompression(tx string, rawSize, encodedSize int) {
+	cm.sink.SetGauge(
+		"beacon_kit.validator.consensus_tx_size", int64(encodedSize), "tx", tx,
+	)
+	if rawSize == 0 {
+		return
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.validator.consensus_tx_compression_ratio",
+		int64(encodedSize*100/rawSize), //nolint:mnd // percent.
+		"tx", tx,
 	)
 }
@@ -89,12 +89,10 @@ type SpecData struct {
 
 	// Fork-related values.
 	//
-	// GenesisTime is the time at which the genesis block was created.
-	GenesisTime uint64 `mapstructure:"genesis-time"`
-	// Deneb1ForkTime is the time at which the Deneb1 fork is activated.
-	Deneb1ForkTime uint64 `mapstructure:"deneb-one-fork-time"`
-	// ElectraForkTime is the time at which the Electra fork is activated.
-	ElectraForkTime uint64 `mapstructure:"electra-fork-time"`
+	// Forks is the fork schedule, in activation order. The first fork is active at genesis, so its
+	// timestamp is the genesis time. The values of this struct are the ones at genesis, before the
+	// overrides of any fork are applied.
+	Forks []ScheduledFork `mapstructure:"forks"`
 
 	// State list lengths
 	//
@@ -133,6 +131,9 @@ type SpecData struct {
 	FieldElementsPerBlob uint64 `mapstructure:"field-elements-per-blob"`
 	// BytesPerBlob denotes the size of EIP-4844 blobs in bytes.
 	BytesPerBlob uint64 `mapstructure:"bytes-per-blob"`
+	// BlobCellProofs denotes whether the execution client returns EIP-7594 cell
+	// proofs (BlobsBundleV2) instead of one proof per blob.
+	BlobCellProofs bool `mapstructure:"blob-cell-proofs"`
 
 	// Berachain Values at genesis
 	//
@@ -140,21 +141,15 @@ type SpecData struct {
 	// for a given epoch
 	// Note: ValidatorSetCap must be smaller than ValidatorRegistryLimit.
 	ValidatorSetCap uint64 `mapstructure:"validator-set-cap"`
-	// EVMInflationAddressGenesis is the address on the EVM which will receive the
+	// EVMInflationAddress is the address on the EVM which will receive the
 	// inflation amount of native EVM balance through a withdrawal every block.
-	EVMInflationAddressGenesis common.ExecutionAddress `mapstructure:"evm-inflation-address"`
-	// EVMInflationPerBlockGenesis is the amount of native EVM balance (in Gwei) to be
+	EVMInflationAddress common.ExecutionAddress `mapstructure:"evm-inflation-address"`
+	// EVMInflationPerBlock is the amount of native EVM balance (in Gwei) to be
 	// minted to the EVMInflationAddress via a withdrawal every block.
-	EVMInflationPerBlockGenesis uint64 `mapstructure:"evm-inflation-per-block"`
-
-	// Deneb1 Value Changes
-	//
-	// EVMInflationAddressDeneb1 is the address on the EVM which will receive the
-	// inflation amount of native EVM balance through a withdrawal every block in the Deneb1 fork.
-	EVMInflationAddressDeneb1 common.ExecutionAddress `mapstructure:"evm-inflation-address-deneb-one"`
-	// EVMInflationPerBlockDeneb1 is the amount of native EVM balance (in Gwei) to be
-	// minted to the EVMInflationAddressDeneb1 via a withdrawal every block in the Deneb1 fork.
-	EVMInflationPerBlockDeneb1 uint64 `mapstructure:"evm-inflation-per-block-deneb-one"`
+	EVMInflationPerBlock uint64 `mapstructure:"evm-inflation-per-block"`
+	// ConsensusTxCompression enables the compressed envelope encoding of the
+	// beacon block and blob sidecars consensus transactions.
+	ConsensusTxCompression bool `mapstructure:"consensus-tx-compression"`
 
 	// Electra Values
 	//
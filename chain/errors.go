@@ -35,4 +35,16 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrEmptyForkSchedule is returned when the chain spec does not schedule
+	// any fork.
+	ErrEmptyForkSchedule = errors.New("fork schedule must not be empty")
+
+	// ErrInvalidForkSchedule is returned when the forks of the schedule are
+	// not properly named, versioned or ordered.
+	ErrInvalidForkSchedule = errors.New("invalid fork schedule")
+
+	// ErrInvalidForkOverride is returned when a fork overrides an unknown or
+	// not fork aware chain spec parameter, or with a value of the wrong type.
+	ErrInvalidForkOverride = errors.New("invalid fork override")
 )
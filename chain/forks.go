@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"math"
+	"reflect"
+	"slices"
+
+	viperlib "github.com/berachain/beacon-kit/config/viper"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/mitchellh/mapstructure"
+)
+
+// forksKey is the key of the fork schedule in the chain spec, which cannot be
+// overridden by a fork.
+const forksKey = "forks"
+
+// forkAwareKeys are the keys of the chain spec parameters that may be
+// overridden by a fork. Only their getters read the parameters of the fork in
+// effect, so overriding any other parameter would have no effect.
+//
+//nolint:gochecknoglobals // read-only set.
+var forkAwareKeys = map[string]struct{}{
+	"evm-inflation-address":    {},
+	"evm-inflation-per-block":  {},
+	"consensus-tx-compression": {},
+	"blob-cell-proofs":         {},
+}
+
+// ScheduledFork is an entry of the fork schedule.
+type ScheduledFork struct {
+	// Name is the human readable name of the fork.
+	Name string `mapstructure:"name"`
+	// Version is the fork version activated by the fork.
+	Version common.Version `mapstructure:"version"`
+	// Timestamp is the time at which the fork is activated.
+	Timestamp uint64 `mapstructure:"timestamp"`
+	// Overrides are the chain spec parameters changed by the fork, keyed by
+	// their `mapstructure` tag in SpecData. Only fork aware parameters may be
+	// overridden. They stay in effect for the following forks unless
+	// overridden again.
+	Overrides map[string]any `mapstructure:"overrides"`
+}
+
+// resolvedFork is a fork of the schedule, along with the chain spec parameters
+// in effect while it is active.
+type resolvedFork struct {
+	ScheduledFork
+	data *SpecData
+}
+
+// validateForkSchedule ensures that the forks are non-empty, uniquely named,
+// of supported and strictly increasing versions, and activated in order.
+func validateForkSchedule(forks []ScheduledFork) error {
+	if len(forks) == 0 {
+		return ErrEmptyForkSchedule
+	}
+
+	names := make(map[string]struct{}, len(forks))
+	for i, fork := range forks {
+		if fork.Name == "" {
+			return errors.Wrapf(ErrInvalidForkSchedule, "fork at index %d has no name", i)
+		}
+		if _, ok := names[fork.Name]; ok {
+			return errors.Wrapf(ErrInvalidForkSchedule, "fork %q is scheduled twice", fork.Name)
+		}
+		names[fork.Name] = struct{}{}
+
+		if !slices.Contains(version.GetSupportedVersions(), fork.Version) {
+			return errors.Wrapf(ErrInvalidForkSchedule,
+				"fork %q has unsupported version %s", fork.Name, fork.Version,
+			)
+		}
+		if i == 0 {
+			continue
+		}
+
+		// Like most chains, BeaconKit does not support arbitrary ordering of forks.
+		prev := forks[i-1]
+		if !version.IsAfter(fork.Version, prev.Version) {
+			return errors.Wrapf(ErrInvalidForkSchedule,
+				"version of fork %q (%s) is not after version of fork %q (%s)",
+				fork.Name, fork.Version, prev.Name, prev.Version,
+			)
+		}
+		if prev.Timestamp > fork.Timestamp {
+			return errors.Wrapf(ErrInvalidForkSchedule,
+				"fork ordering violation: timestamp at index %d (%d) > index %d (%d)",
+				i-1, prev.Timestamp, i, fork.Timestamp,
+			)
+		}
+	}
+	return nil
+}
+
+// resolveForks applies the overrides of every fork of the schedule, in order,
+// on top of the base parameters. It returns the resolved forks along with the
+// schedule with its overrides decoded to the type of the parameters.
+func resolveForks(base *SpecData) ([]resolvedFork, []ScheduledFork, error) {
+	var (
+		forks    = make([]resolvedFork, len(base.Forks))
+		schedule = make([]ScheduledFork, len(base.Forks))
+		current  = *base
+	)
+	for i, fork := range base.Forks {
+		data, err := applyOverrides(current, fork.Overrides)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "fork %q", fork.Name)
+		}
+		if err = validateSpecData(&data); err != nil {
+			return nil, nil, errors.Wrapf(err, "fork %q", fork.Name)
+		}
+
+		schedule[i] = fork
+		schedule[i].Overrides = decodedOverrides(&data, fork.Overrides)
+		forks[i] = resolvedFork{ScheduledFork: schedule[i], data: &data}
+		current = data
+	}
+
+	for i := range forks {
+		forks[i].data.Forks = schedule
+	}
+	return forks, schedule, nil
+}
+
+// applyOverrides returns a copy of the data with the given overrides applied.
+func applyOverrides(data SpecData, overrides map[string]any) (SpecData, error) {
+	if _, ok := overrides[forksKey]; ok {
+		return data, errors.Wrapf(ErrInvalidForkOverride, "%s cannot be overridden", forksKey)
+	}
+	for key := range overrides {
+		if _, ok := forkAwareKeys[key]; !ok {
+			return data, errors.Wrapf(ErrInvalidForkOverride, "%s is not fork aware", key)
+		}
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			viperlib.StringToExecutionAddressFunc(),
+			viperlib.NumericToDomainTypeFunc(),
+		),
+		ErrorUnused: true,
+		Result:      &data,
+	})
+	if err != nil {
+		return data, err
+	}
+	if err = decoder.Decode(overrides); err != nil {
+		return data, errors.Wrap(ErrInvalidForkOverride, err.Error())
+	}
+	return data, nil
+}
+
+// decodedOverrides returns the overrides with their values taken from the
+// data they were applied to, so that equal overrides compare equal whether
+// they come from a TOML file or not.
+func decodedOverrides(data *SpecData, overrides map[string]any) map[string]any {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	decoded := make(map[string]any, len(overrides))
+	value, typ := reflect.ValueOf(data).Elem(), reflect.TypeOf(*data)
+	for i := range typ.NumField() {
+		tag := typ.Field(i).Tag.Get("mapstructure")
+		if _, ok := overrides[tag]; ok {
+			decoded[tag] = value.Field(i).Interface()
+		}
+	}
+	return decoded
+}
+
+// forkAt returns the fork active at the given timestamp, which is the last
+// fork activated at or before it. The first fork is considered active before
+// its activation.
+func (s spec) forkAt(timestamp uint64) resolvedFork {
+	for i := len(s.forks) - 1; i > 0; i-- {
+		if timestamp >= s.forks[i].Timestamp {
+			return s.forks[i]
+		}
+	}
+	return s.forks[0]
+}
+
+// forkTime returns the time at which the given fork version takes effect,
+// which is the activation time of the first fork at or after that version.
+// It returns math.MaxUint64 if no such fork is scheduled.
+func (s spec) forkTime(v common.Version) uint64 {
+	for _, fork := range s.forks {
+		if version.EqualsOrIsAfter(fork.Version, v) {
+			return fork.Timestamp
+		}
+	}
+	return math.MaxUint64
+}
@@ -35,8 +35,11 @@ import (
 // Create an instance of chainSpec with test data.
 var spec, _ = chain.NewSpec(
 	&chain.SpecData{
-		Deneb1ForkTime:                   9 * 32 * 2,
-		ElectraForkTime:                  10 * 32 * 2,
+		Forks: []chain.ScheduledFork{
+			{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+			{Name: "deneb1", Version: version.Deneb1(), Timestamp: 9 * 32 * 2},
+			{Name: "electra", Version: version.Electra(), Timestamp: 10 * 32 * 2},
+		},
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
 		MaxWithdrawalsPerPayload:         2,
@@ -52,6 +55,9 @@ func TestActiveForkVersionForTimestamp(t *testing.T) {
 		timestamp uint64
 		expected  common.Version
 	}{
+		{name: "At Genesis", timestamp: spec.GenesisTime(), expected: version.Deneb()},
+		{name: "Before Deneb1 Fork", timestamp: spec.Deneb1ForkTime() - 1, expected: version.Deneb()},
+		{name: "At Deneb1 Fork", timestamp: spec.Deneb1ForkTime(), expected: version.Deneb1()},
 		{name: "Before Electra Fork", timestamp: spec.ElectraForkTime() - 1, expected: version.Deneb1()},
 		{name: "At Electra Fork", timestamp: spec.ElectraForkTime(), expected: version.Electra()},
 		{name: "After Electra Fork", timestamp: spec.ElectraForkTime() + 1, expected: version.Electra()},
@@ -21,8 +21,6 @@
 package chain
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
@@ -103,6 +101,13 @@ type ForkSpec interface {
 
 	// ElectraForkTime returns the time at which the Electra fork takes effect.
 	ElectraForkTime() uint64
+
+	// ForkSchedule returns the scheduled forks, in activation order.
+	ForkSchedule() []ScheduledFork
+
+	// SpecDataAtTimestamp returns the chain spec parameters in effect at the given timestamp,
+	// with the overrides of every fork activated by then applied. It must not be modified.
+	SpecDataAtTimestamp(timestamp math.U64) *SpecData
 }
 
 type BlobSpec interface {
@@ -123,6 +128,10 @@ type BlobSpec interface {
 	// BytesPerBlob returns the number of bytes per blob.
 	BytesPerBlob() uint64
 
+	// BlobCellProofs returns whether the execution client returns cell proofs
+	// for the blobs of payloads built for the given fork version.
+	BlobCellProofs(forkVersion common.Version) bool
+
 	// MinEpochsForBlobsSidecarsRequest returns the minimum number of epochs for
 	// blob sidecar requests.
 	MinEpochsForBlobsSidecarsRequest() math.Epoch
@@ -147,6 +156,10 @@ type BerachainSpec interface {
 	// to be minted to the EVMInflationAddress via a withdrawal every block.
 	EVMInflationPerBlock(timestamp math.U64) math.Gwei
 
+	// ConsensusTxCompression returns whether the beacon block and blob
+	// sidecars consensus transactions are wrapped in the compressed envelope.
+	ConsensusTxCompression(timestamp math.U64) bool
+
 	// ValidatorSetCap retrieves the maximum number of validators allowed in the active set.
 	ValidatorSetCap() uint64
 }
@@ -224,46 +237,42 @@ type Spec interface {
 
 // spec is a concrete implementation of the Spec interface, holding the actual data.
 type spec struct {
-	// Data contains the actual chain-specific parameter values.
+	// Data contains the actual chain-specific parameter values at genesis.
 	Data *SpecData
+
+	// forks holds the scheduled forks along with the parameters in effect while they are active.
+	forks []resolvedFork
 }
 
 // NewSpec creates a new instance of a Spec with the provided data.
 func NewSpec(data *SpecData) (Spec, error) {
-	s := spec{Data: data}
-	return s, s.validate()
+	if err := validateForkSchedule(data.Forks); err != nil {
+		return spec{Data: data}, err
+	}
+	forks, schedule, err := resolveForks(data)
+	if err != nil {
+		return spec{Data: data}, err
+	}
+
+	// The data is copied so that the schedule holds the decoded overrides.
+	genesis := *data
+	genesis.Forks = schedule
+	return spec{Data: &genesis, forks: forks}, nil
 }
 
-// validate ensures that the chain spec is valid, returning error if it is not.
-func (s spec) validate() error {
-	if s.Data.MaxWithdrawalsPerPayload <= 1 {
+// validateSpecData ensures that the chain spec parameters are valid, returning error if they are
+// not. It is run against the parameters in effect during every fork.
+func validateSpecData(data *SpecData) error {
+	if data.MaxWithdrawalsPerPayload <= 1 {
 		return ErrInsufficientMaxWithdrawalsPerPayload
 	}
 
-	if s.Data.ValidatorSetCap > s.Data.ValidatorRegistryLimit {
+	if data.ValidatorSetCap > data.ValidatorRegistryLimit {
 		return ErrInvalidValidatorSetCap
 	}
 
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
-	// Enforce ordering of the forks. Like most chains, BeaconKit does not support arbitrary ordering of forks.
-	// Fork times here are in chronological order
-	orderedForkTimes := []uint64{
-		s.Data.GenesisTime,
-		s.Data.Deneb1ForkTime,
-		s.Data.ElectraForkTime,
-	}
-	for i := 1; i < len(orderedForkTimes); i++ {
-		prev, cur := orderedForkTimes[i-1], orderedForkTimes[i]
-		// must not go backwards
-		if prev > cur {
-			return fmt.Errorf(
-				"fork ordering violation: timestamp at index %d (%d) > index %d (%d)",
-				i-1, prev, i, cur,
-			)
-		}
-	}
-
 	// TODO: Add more validation rules here.
 	return nil
 }
@@ -380,17 +389,27 @@ func (s spec) TargetSecondsPerEth1Block() uint64 {
 
 // GenesisTime returns the time at which the genesis block was created.
 func (s spec) GenesisTime() uint64 {
-	return s.Data.GenesisTime
+	return s.forks[0].Timestamp
 }
 
-// Deneb1ForkTime returns the epoch of the Deneb1 fork.
+// Deneb1ForkTime returns the time of the Deneb1 fork.
 func (s spec) Deneb1ForkTime() uint64 {
-	return s.Data.Deneb1ForkTime
+	return s.forkTime(version.Deneb1())
 }
 
-// ElectraForkTime returns the epoch of the Electra fork.
+// ElectraForkTime returns the time of the Electra fork.
 func (s spec) ElectraForkTime() uint64 {
-	return s.Data.ElectraForkTime
+	return s.forkTime(version.Electra())
+}
+
+// ForkSchedule returns the scheduled forks, in activation order.
+func (s spec) ForkSchedule() []ScheduledFork {
+	return s.Data.Forks
+}
+
+// SpecDataAtTimestamp returns the chain spec parameters in effect at the given timestamp.
+func (s spec) SpecDataAtTimestamp(timestamp math.U64) *SpecData {
+	return s.forkAt(timestamp.Unwrap()).data
 }
 
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
@@ -455,6 +474,18 @@ func (s spec) BytesPerBlob() uint64 {
 	return s.Data.BytesPerBlob
 }
 
+// BlobCellProofs returns whether the execution client returns cell proofs for
+// the blobs of payloads built for the given fork version. The fork version is
+// used rather than a timestamp since payloads are cached by fork version.
+func (s spec) BlobCellProofs(forkVersion common.Version) bool {
+	for _, fork := range s.forks {
+		if fork.Version == forkVersion {
+			return fork.data.BlobCellProofs
+		}
+	}
+	return false
+}
+
 // ValidatorSetCap retrieves the maximum number of validators allowed in the active set.
 func (s spec) ValidatorSetCap() uint64 {
 	return s.Data.ValidatorSetCap
@@ -463,27 +494,17 @@ func (s spec) ValidatorSetCap() uint64 {
 // EVMInflationAddress returns the address on the EVM which will receive the
 // inflation amount of native EVM balance through a withdrawal every block.
 func (s spec) EVMInflationAddress(timestamp math.U64) common.ExecutionAddress {
-	fv := s.ActiveForkVersionForTimestamp(timestamp)
-	switch fv {
-	case version.Deneb1(), version.Electra():
-		return s.Data.EVMInflationAddressDeneb1
-	case version.Deneb():
-		return s.Data.EVMInflationAddressGenesis
-	default:
-		panic(fmt.Sprintf("EVMInflationAddress not supported for this fork version: %d", fv))
-	}
+	return s.SpecDataAtTimestamp(timestamp).EVMInflationAddress
 }
 
 // EVMInflationPerBlock returns the amount of native EVM balance (in Gwei) to
 // be minted to the EVMInflationAddress via a withdrawal every block.
 func (s spec) EVMInflationPerBlock(timestamp math.U64) math.Gwei {
-	fv := s.ActiveForkVersionForTimestamp(timestamp)
-	switch fv {
-	case version.Deneb1(), version.Electra():
-		return math.Gwei(s.Data.EVMInflationPerBlockDeneb1)
-	case version.Deneb():
-		return math.Gwei(s.Data.EVMInflationPerBlockGenesis)
-	default:
-		panic(fmt.Sprintf("EVMInflationPerBlock not supported for this fork version: %d", fv))
-	}
+	return math.Gwei(s.SpecDataAtTimestamp(timestamp).EVMInflationPerBlock)
+}
+
+// ConsensusTxCompression returns whether the beacon block and blob sidecars
+// consensus transactions are wrapped in the compressed envelope.
+func (s spec) ConsensusTxCompression(timestamp math.U64) bool {
+	return s.SpecDataAtTimestamp(timestamp).ConsensusTxCompression
 }
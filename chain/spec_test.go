@@ -24,6 +24,9 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,12 +39,18 @@ func baseSpecData() *chain.SpecData {
 	}
 }
 
+func forkSchedule(genesisTime, deneb1ForkTime, electraForkTime uint64) []chain.ScheduledFork {
+	return []chain.ScheduledFork{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: genesisTime},
+		{Name: "deneb1", Version: version.Deneb1(), Timestamp: deneb1ForkTime},
+		{Name: "electra", Version: version.Electra(), Timestamp: electraForkTime},
+	}
+}
+
 func TestValidate_ForkOrder_Success(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
-	data.GenesisTime = 10
-	data.Deneb1ForkTime = 20
-	data.ElectraForkTime = 30
+	data.Forks = forkSchedule(10, 20, 30)
 
 	_, err := chain.NewSpec(data)
 	require.NoError(t, err)
@@ -50,34 +59,187 @@ func TestValidate_ForkOrder_Success(t *testing.T) {
 func TestValidate_ForkOrder_GenesisAfterDeneb(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
-	data.GenesisTime = 50
-	data.Deneb1ForkTime = 20
-	data.ElectraForkTime = 60
+	data.Forks = forkSchedule(50, 20, 60)
 
 	_, err := chain.NewSpec(data)
-	require.Error(t, err)
+	require.ErrorIs(t, err, chain.ErrInvalidForkSchedule)
 	require.Contains(t, err.Error(), "timestamp at index 0 (50) > index 1 (20)")
 }
 
 func TestValidate_ForkOrder_DenebAfterElectra(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
-	data.GenesisTime = 10
-	data.Deneb1ForkTime = 80
-	data.ElectraForkTime = 40
+	data.Forks = forkSchedule(10, 80, 40)
 
 	_, err := chain.NewSpec(data)
-	require.Error(t, err)
+	require.ErrorIs(t, err, chain.ErrInvalidForkSchedule)
 	require.Contains(t, err.Error(), "timestamp at index 1 (80) > index 2 (40)")
 }
 
 func TestValidate_ForkOrder_AllForksAtGenesis(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
-	data.GenesisTime = 0
-	data.Deneb1ForkTime = 0
-	data.ElectraForkTime = 0
+	data.Forks = forkSchedule(0, 0, 0)
 
 	_, err := chain.NewSpec(data)
 	require.NoError(t, err)
 }
+
+func TestValidate_ForkSchedule_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		forks func([]chain.ScheduledFork) []chain.ScheduledFork
+		err   error
+	}{
+		{
+			name:  "empty schedule",
+			forks: func([]chain.ScheduledFork) []chain.ScheduledFork { return nil },
+			err:   chain.ErrEmptyForkSchedule,
+		},
+		{
+			name: "unnamed fork",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[1].Name = ""
+				return forks
+			},
+			err: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name: "duplicate name",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[2].Name = forks[1].Name
+				return forks
+			},
+			err: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name: "unsupported version",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[2].Version = version.Electra1()
+				return forks
+			},
+			err: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name: "versions going backwards",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[1].Version, forks[2].Version = forks[2].Version, forks[1].Version
+				return forks
+			},
+			err: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name: "unknown override",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[1].Overrides = map[string]any{"unknown-parameter": 1}
+				return forks
+			},
+			err: chain.ErrInvalidForkOverride,
+		},
+		{
+			name: "override of the schedule",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[1].Overrides = map[string]any{"forks": nil}
+				return forks
+			},
+			err: chain.ErrInvalidForkOverride,
+		},
+		{
+			name: "override of a parameter not fork aware",
+			forks: func(forks []chain.ScheduledFork) []chain.ScheduledFork {
+				forks[2].Overrides = map[string]any{"validator-set-cap": 50}
+				return forks
+			},
+			err: chain.ErrInvalidForkOverride,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data := baseSpecData()
+			data.Forks = tt.forks(forkSchedule(10, 20, 30))
+
+			_, err := chain.NewSpec(data)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestSpecDataAtTimestamp(t *testing.T) {
+	t.Parallel()
+	genesisAddress := common.NewExecutionAddressFromHex("0x6942069420694206942069420694206942069420")
+	deneb1Address := common.NewExecutionAddressFromHex("0x4206942069420694206942069420694206942069")
+
+	data := baseSpecData()
+	data.EVMInflationAddress = genesisAddress
+	data.EVMInflationPerBlock = 10
+	data.Forks = forkSchedule(10, 20, 30)
+	// Overrides may be given as strings and signed integers, as read from TOML.
+	data.Forks[1].Overrides = map[string]any{
+		"evm-inflation-address":   deneb1Address.String(),
+		"evm-inflation-per-block": int64(11),
+	}
+	data.Forks[2].Overrides = map[string]any{
+		"consensus-tx-compression": true,
+	}
+
+	cs, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	// Before genesis and until Deneb1, the genesis values are in effect.
+	for _, ts := range []math.U64{0, 10, 19} {
+		require.Equal(t, genesisAddress, cs.EVMInflationAddress(ts))
+		require.Equal(t, math.Gwei(10), cs.EVMInflationPerBlock(ts))
+		require.False(t, cs.ConsensusTxCompression(ts))
+	}
+
+	// Deneb1 overrides stay in effect after Electra, which adds its own.
+	for _, ts := range []math.U64{20, 29, 30, 1000} {
+		require.Equal(t, deneb1Address, cs.EVMInflationAddress(ts))
+		require.Equal(t, math.Gwei(11), cs.EVMInflationPerBlock(ts))
+	}
+	require.False(t, cs.ConsensusTxCompression(29))
+	require.True(t, cs.ConsensusTxCompression(30))
+	require.True(t, cs.SpecDataAtTimestamp(1000).ConsensusTxCompression)
+
+	// The schedule holds the overrides decoded to the type of the parameters.
+	require.Equal(t, deneb1Address, cs.ForkSchedule()[1].Overrides["evm-inflation-address"])
+	require.Equal(t, uint64(11), cs.ForkSchedule()[1].Overrides["evm-inflation-per-block"])
+}
+
+func TestForkTimes(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = forkSchedule(10, 20, 30)
+	cs, err := chain.NewSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), cs.GenesisTime())
+	require.Equal(t, uint64(20), cs.Deneb1ForkTime())
+	require.Equal(t, uint64(30), cs.ElectraForkTime())
+	require.Equal(t, version.Deneb(), cs.GenesisForkVersion())
+
+	// A chain starting on Electra activates Deneb1 at genesis too.
+	data = baseSpecData()
+	data.Forks = []chain.ScheduledFork{{Name: "electra", Version: version.Electra(), Timestamp: 10}}
+	cs, err = chain.NewSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), cs.Deneb1ForkTime())
+	require.Equal(t, version.Electra(), cs.GenesisForkVersion())
+}
+
+func TestBlobCellProofs(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = forkSchedule(10, 20, 30)
+	data.Forks[2].Overrides = map[string]any{"blob-cell-proofs": true}
+	cs, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	require.False(t, cs.BlobCellProofs(version.Deneb()))
+	require.False(t, cs.BlobCellProofs(version.Deneb1()))
+	require.True(t, cs.BlobCellProofs(version.Electra()))
+
+	// Versions that are not scheduled never use cell proofs.
+	require.False(t, cs.BlobCellProofs(version.Electra1()))
+}
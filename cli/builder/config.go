@@ -43,7 +43,6 @@ func DefaultAppConfig() any {
 
 	// Start with the default server configuration.
 	cfg := serverconfig.DefaultConfig()
-	cfg.Telemetry.Enabled = true
 
 	// BeaconKit forces PebbleDB as the database backend.
 	cfg.Pruning = "everything"
@@ -52,11 +51,12 @@ func DefaultAppConfig() any {
 	cfg.IAVLDisableFastNode = true
 	cfg.IAVLCacheSize = 2500
 
-	// Create the custom app configuration.
+	// Create the custom app configuration, serving metrics by default.
 	customAppConfig := CustomAppConfig{
 		Config:    *cfg,
 		BeaconKit: config.DefaultConfig(),
 	}
+	customAppConfig.BeaconKit.Metrics.Enabled = true
 
 	return customAppConfig
 }
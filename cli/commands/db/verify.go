@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagRepair    = "repair"
+	flagMaxBlocks = "max-blocks"
+)
+
+var (
+	// ErrInconsistentDB is returned when the verification found
+	// inconsistencies that were not repaired.
+	ErrInconsistentDB = errors.New("database is inconsistent")
+)
+
+// NewVerifyCmd creates a new command checking the consistency of all the
+// stores of the node.
+//
+//nolint:lll // reads better if long description is one line
+func NewVerifyCmd(chainSpecCreator servertypes.ChainSpecCreator, appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Checks the consistency of the beacon state, deposit, blob sidecar and CometBFT block stores",
+		Long:  `This command checks, with the node stopped, that the height of the application state matches the CometBFT block store, that the deposit store is in sync with the beacon state, that the blocks stored by CometBFT match the block and state roots recorded in the beacon state, and that the blob sidecars of every block within the data availability window are stored. The beacon block store is an in-memory index rebuilt from finalized blocks, so blocks are checked against the CometBFT block store instead. With --repair, missing blob sidecars are restored from the blocks stored by CometBFT.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			repair, err := cmd.Flags().GetBool(flagRepair)
+			if err != nil {
+				return err
+			}
+			maxBlocks, err := cmd.Flags().GetInt64(flagMaxBlocks)
+			if err != nil {
+				return err
+			}
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := storagedb.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+			blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(blockDB)
+			defer blockStore.Close()
+
+			vr := &verifier{
+				logger:     logger,
+				chainSpec:  chainSpec,
+				app:        appCreator(logger, appDB, nil, cfg, v),
+				blockStore: blockStore,
+				repair:     repair,
+				maxBlocks:  maxBlocks,
+			}
+			return vr.verify(cmd)
+		},
+	}
+
+	cmd.Flags().Bool(flagRepair, false, "Repair the inconsistencies that can be recovered from the CometBFT block store")
+	cmd.Flags().Int64(flagMaxBlocks, 0, "Maximum number of the latest blocks to check (defaults to all blocks within the retained windows)")
+	return cmd
+}
+
+// verifier checks the consistency of the stores of a node.
+type verifier struct {
+	logger     *phuslu.Logger
+	chainSpec  chain.Spec
+	app        types.Node
+	blockStore *store.BlockStore
+	repair     bool
+	maxBlocks  int64
+
+	// issues is the number of inconsistencies found and not repaired.
+	issues int
+}
+
+// report records an inconsistency that was not repaired.
+func (vr *verifier) report(msg string, keyVals ...any) {
+	vr.issues++
+	vr.logger.Warn("❌ "+msg, keyVals...)
+}
+
+// verify runs all the checks against the latest committed state.
+func (vr *verifier) verify(cmd *cobra.Command) error {
+	height := vr.app.CommitMultiStore().LastCommitID().Version
+	ctx := sdk.NewContext(
+		vr.app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(vr.logger),
+	).WithContext(cmd.Context())
+	st := vr.app.StorageBackend().StateFromContext(ctx)
+
+	vr.checkHeights(height)
+	if err := vr.checkDeposits(ctx, st); err != nil {
+		return err
+	}
+	if err := vr.checkBlocks(cmd, st, height); err != nil {
+		return err
+	}
+
+	if vr.issues > 0 {
+		return errors.Wrapf(ErrInconsistentDB, "%d inconsistencies found", vr.issues)
+	}
+	vr.logger.Info("✅ All stores are consistent!", "height", height)
+	return nil
+}
+
+// checkHeights checks that the application state is at the height of the
+// CometBFT block store, or one block behind if the node stopped before
+// committing the last stored block.
+func (vr *verifier) checkHeights(height int64) {
+	blockHeight := vr.blockStore.Height()
+	if blockHeight != height && blockHeight != height+1 {
+		vr.report(
+			"Application state height does not match the CometBFT block store",
+			"state_height", height, "block_store_height", blockHeight,
+		)
+	}
+}
+
+// checkDeposits checks that the deposit store is in sync with the beacon
+// state's deposit root.
+func (vr *verifier) checkDeposits(ctx sdk.Context, st *statedb.StateDB) error {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	if err = core.ValidateNonGenesisDeposits(
+		ctx, st, vr.app.StorageBackend().DepositStore(), 0, nil, eth1Data.DepositRoot,
+	); err != nil {
+		vr.report("Deposit store is not in sync with the beacon state", "error", err)
+	}
+	return nil
+}
+
+// checkBlocks checks the blocks stored by CometBFT, from the latest committed
+// one backwards, against the roots recorded in the beacon state and the
+// stored blob sidecars, as long as they are within the retained windows.
+func (vr *verifier) checkBlocks(cmd *cobra.Command, st *statedb.StateDB, height int64) error {
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	historyLen := vr.chainSpec.SlotsPerHistoricalRoot()
+
+	for h := height; h >= max(vr.blockStore.Base(), 1); h-- {
+		if vr.maxBlocks > 0 && height-h >= vr.maxBlocks {
+			break
+		}
+		block, _ := vr.blockStore.LoadBlock(h)
+		if block == nil {
+			vr.report("Block missing from the CometBFT block store", "height", h)
+			continue
+		}
+		blk, err := vr.decodeBlock(block)
+		if err != nil {
+			vr.report("Failed to decode stored block", "height", h, "error", err)
+			continue
+		}
+
+		slot := blk.GetSlot()
+		inHistory := slot == stateSlot || stateSlot.Unwrap()-slot.Unwrap() < historyLen
+		inDAPeriod := vr.chainSpec.WithinDAPeriod(slot, stateSlot)
+		if !inHistory && !inDAPeriod {
+			break
+		}
+		if inHistory {
+			if err = vr.checkRoots(st, stateSlot, blk); err != nil {
+				return err
+			}
+		}
+		if inDAPeriod {
+			if err = vr.checkSidecars(cmd, block, blk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkRoots checks the block against the block and state roots recorded in
+// the beacon state at the given slot.
+func (vr *verifier) checkRoots(st *statedb.StateDB, stateSlot math.Slot, blk *ctypes.BeaconBlock) error {
+	slot := blk.GetSlot()
+	if slot == stateSlot {
+		// The roots of the latest block are only recorded at the next slot, so
+		// it is checked against the latest block header and state instead.
+		latestHeader, err := st.GetLatestBlockHeader()
+		if err != nil {
+			return err
+		}
+		header := blk.GetHeader()
+		header.SetStateRoot(common.Root{})
+		if header.HashTreeRoot() != latestHeader.HashTreeRoot() {
+			vr.report("Latest block header does not match the latest stored block", "slot", slot)
+		}
+		if stateRoot := st.HashTreeRoot(); stateRoot != blk.GetStateRoot() {
+			vr.report(
+				"Beacon state root does not match the latest stored block",
+				"slot", slot, "state_root", stateRoot, "block_state_root", blk.GetStateRoot(),
+			)
+		}
+		return nil
+	}
+
+	index := slot.Unwrap() % vr.chainSpec.SlotsPerHistoricalRoot()
+	blockRoot, err := st.GetBlockRootAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if blockRoot != blk.HashTreeRoot() {
+		vr.report(
+			"Block root recorded in the beacon state does not match the stored block",
+			"slot", slot, "state_block_root", blockRoot, "block_root", blk.HashTreeRoot(),
+		)
+	}
+	stateRoot, err := st.StateRootAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if stateRoot != blk.GetStateRoot() {
+		vr.report(
+			"State root recorded in the beacon state does not match the stored block",
+			"slot", slot, "state_root", stateRoot, "block_state_root", blk.GetStateRoot(),
+		)
+	}
+	return nil
+}
+
+// checkSidecars checks that the availability store holds exactly the blob
+// sidecars committed to by the block, restoring the missing ones from the
+// stored block if repairing.
+func (vr *verifier) checkSidecars(cmd *cobra.Command, block *cmttypes.Block, blk *ctypes.BeaconBlock) error {
+	slot := blk.GetSlot()
+	availabilityStore := vr.app.StorageBackend().AvailabilityStore()
+	stored, err := availabilityStore.GetBlobSidecars(slot)
+	if err != nil {
+		return err
+	}
+
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	committed := make(map[eip4844.KZGCommitment]struct{}, len(commitments))
+	for _, commitment := range commitments {
+		committed[commitment] = struct{}{}
+	}
+	for _, sidecar := range stored {
+		if _, ok := committed[sidecar.GetKzgCommitment()]; !ok {
+			vr.report("Stored blob sidecar is not committed to by the block", "slot", slot, "index", sidecar.GetIndex())
+			continue
+		}
+		if sidecar.GetBeaconBlockHeader().HashTreeRoot() != blk.HashTreeRoot() {
+			vr.report("Stored blob sidecar does not match the block header", "slot", slot, "index", sidecar.GetIndex())
+		}
+	}
+
+	if availabilityStore.IsDataAvailable(cmd.Context(), slot, blk.GetBody()) {
+		return nil
+	}
+	if !vr.repair {
+		vr.report("Blob sidecars missing within the availability window", "slot", slot)
+		return nil
+	}
+
+	txs, err := vr.decodeTxs(block)
+	if err != nil {
+		vr.report("Failed to decode the stored block txs", "slot", slot, "error", err)
+		return nil
+	}
+	sidecars, err := encoding.UnmarshalBlobSidecarsFromABCIRequest(
+		txs, blockchain.BlobSidecarsTxIndex,
+	)
+	if err != nil {
+		vr.report("Failed to decode blob sidecars of the stored block", "slot", slot, "error", err)
+		return nil
+	}
+	for _, sidecar := range sidecars {
+		if sidecar.GetBeaconBlockHeader().HashTreeRoot() != blk.HashTreeRoot() {
+			vr.report("Blob sidecar of the stored block does not match its header", "slot", slot)
+			return nil
+		}
+	}
+	if err = availabilityStore.Persist(sidecars); err != nil {
+		return err
+	}
+	if !availabilityStore.IsDataAvailable(cmd.Context(), slot, blk.GetBody()) {
+		vr.report("Blob sidecars still missing after repair", "slot", slot)
+		return nil
+	}
+	vr.logger.Info("🔧 Restored blob sidecars from the CometBFT block store", "slot", slot, "num_sidecars", len(sidecars))
+	return nil
+}
+
+// decodeBlock decodes the beacon block included in a CometBFT block.
+func (vr *verifier) decodeBlock(block *cmttypes.Block) (*ctypes.BeaconBlock, error) {
+	txs, err := vr.decodeTxs(block)
+	if err != nil {
+		return nil, err
+	}
+	signedBlk, err := encoding.UnmarshalBeaconBlockFromABCIRequest(
+		txs,
+		blockchain.BeaconBlockTxIndex,
+		vr.chainSpec.ActiveForkVersionForTimestamp(math.U64(block.Time.Unix())), // #nosec G115
+	)
+	if err != nil {
+		return nil, err
+	}
+	return signedBlk.GetBeaconBlock(), nil
+}
+
+// decodeTxs unwraps the consensus txs of a CometBFT block from the envelope
+// active at its block time.
+func (vr *verifier) decodeTxs(block *cmttypes.Block) ([][]byte, error) {
+	return envelope.DecodeTxs(
+		block.Txs.ToSliceOfBytes(),
+		vr.chainSpec.ConsensusTxCompression(math.U64(block.Time.Unix())), // #nosec G115
+		envelope.MaxDecodedTxSize,
+	)
+}
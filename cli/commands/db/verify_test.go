@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package db_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	nodestorage "github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode exposes the stores of a node to the verify command.
+type testNode struct {
+	cms     store.CommitMultiStore
+	backend *nodestorage.Backend
+}
+
+func (n *testNode) CommitMultiStore() store.CommitMultiStore { return n.cms }
+
+func (n *testNode) StorageBackend() blockchain.StorageBackend { return n.backend }
+
+func (n *testNode) Start(context.Context) error { return nil }
+
+// verifyFixture is a node at height 1, whose block carries a blob sidecar.
+type verifyFixture struct {
+	cs                chain.Spec
+	rootDir           string
+	depositStore      deposit.StoreManager
+	availabilityStore *dastore.Store
+	sidecars          datypes.BlobSidecars
+}
+
+func newVerifyFixture(t *testing.T) *verifyFixture {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	f := &verifyFixture{
+		cs:           cs,
+		rootDir:      t.TempDir(),
+		depositStore: deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger()),
+	}
+	logger := log.NewNopLogger()
+	f.availabilityStore = dastore.New(
+		filedb.NewRangeDB(filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0700),
+			filedb.WithLogger(logger),
+		)),
+		logger,
+	)
+
+	blockTime := time.Unix(1, 0)
+	blk := f.writeState(t, blockTime)
+	f.sidecars = datypes.BlobSidecars{datypes.BuildBlobSidecar(
+		0,
+		ctypes.NewSignedBeaconBlockHeader(blk.GetHeader(), crypto.BLSSignature{}),
+		&eip4844.Blob{},
+		blk.GetBody().GetBlobKzgCommitments()[0],
+		eip4844.KZGProof{},
+		make([]common.Root, ctypes.KZGInclusionProofDepth),
+	)}
+	f.writeBlock(t, blk, blockTime)
+	require.NoError(t, f.availabilityStore.Persist(f.sidecars))
+	return f
+}
+
+// writeState commits, at height 1, the state following a block at slot 1
+// on top of a genesis with two validators, and returns that block.
+func (f *verifyFixture) writeState(t *testing.T, blockTime time.Time) *ctypes.BeaconBlock {
+	t.Helper()
+	appDB, err := storagedb.OpenDB(f.rootDir, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, appDB.Close()) }()
+
+	cms := store.NewCommitMultiStore(appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	sdkCtx := sdk.NewContext(cms, false, log.NewNopLogger())
+	kvStore := beacondb.New(&storage.KVStoreService{Key: storage.StoreKey})
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), f.cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	deposits := ctypes.Deposits{
+		{Pubkey: crypto.BLSPubkey{0x01}, Amount: f.cs.MaxEffectiveBalance(), Index: 0},
+		{Pubkey: crypto.BLSPubkey{0x02}, Amount: f.cs.MaxEffectiveBalance(), Index: 1},
+	}
+	require.NoError(t, f.depositStore.EnqueueDeposits(context.Background(), deposits))
+	sp, _, _, _, _, _ := statetransition.SetupTestState(t, f.cs)
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, deposits,
+		ctypes.NewEmptyExecutionPayloadHeaderWithVersion(f.cs.GenesisForkVersion()),
+		f.cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	blk, err := ctypes.NewBeaconBlockWithVersion(
+		1, 0, common.Root{}, f.cs.ActiveForkVersionForTimestamp(math.U64(blockTime.Unix())),
+	)
+	require.NoError(t, err)
+	blk.GetBody().SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash]{{0x01}})
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, st.SetLatestBlockHeader(blk.GetHeader()))
+	blk.SetStateRoot(st.HashTreeRoot())
+	cms.Commit()
+	return blk
+}
+
+// writeBlock stores, at height 1 of the CometBFT block store, a block
+// carrying the beacon block and the fixture's sidecars.
+func (f *verifyFixture) writeBlock(t *testing.T, blk *ctypes.BeaconBlock, blockTime time.Time) {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, f.rootDir)
+	blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{
+		ID: "blockstore", Config: clicontext.GetConfigFromViper(v),
+	})
+	require.NoError(t, err)
+	blockStore := cmtstore.NewBlockStore(blockDB)
+	defer func() { require.NoError(t, blockStore.Close()) }()
+
+	compress := f.cs.ConsensusTxCompression(math.U64(blockTime.Unix()))
+	blkBz, err := (&ctypes.SignedBeaconBlock{BeaconBlock: blk}).MarshalSSZ()
+	require.NoError(t, err)
+	blkTx, err := envelope.EncodeTx(blkBz, compress)
+	require.NoError(t, err)
+	sidecarsBz, err := f.sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	sidecarsTx, err := envelope.EncodeTx(sidecarsBz, compress)
+	require.NoError(t, err)
+
+	block := cmttypes.MakeBlock(1, []cmttypes.Tx{blkTx, sidecarsTx}, &cmttypes.Commit{}, nil)
+	block.Time = blockTime
+	block.ProposerAddress = make([]byte, cmtcrypto.AddressSize)
+	parts, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockStore.SaveBlock(block, parts, &cmttypes.Commit{Height: 1, BlockID: cmttypes.BlockID{
+		Hash: block.Hash(), PartSetHeader: parts.Header(),
+	}})
+}
+
+// runVerify runs the verify command with the given arguments against the
+// fixture.
+func (f *verifyFixture) runVerify(t *testing.T, args ...string) error {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, f.rootDir)
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(io.Discard, nil))
+
+	appCreator := func(
+		_ *phuslu.Logger, appDB dbm.DB, _ io.Writer, _ *cmtcfg.Config, _ servertypes.AppOptions,
+	) types.Node {
+		cms := store.NewCommitMultiStore(appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+		cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, cms.LoadLatestVersion())
+		backend := nodestorage.NewBackend(
+			f.cs, f.availabilityStore, beacondb.New(&storage.KVStoreService{Key: storage.StoreKey}),
+			f.depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+		)
+		return &testNode{cms: cms, backend: backend}
+	}
+
+	cmd := db.NewVerifyCmd(func(servertypes.AppOptions) (chain.Spec, error) { return f.cs, nil }, appCreator)
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestVerifyRepairsSidecars(t *testing.T) {
+	t.Parallel()
+	f := newVerifyFixture(t)
+	require.NoError(t, f.runVerify(t))
+
+	// Drop the sidecars of the stored block.
+	require.NoError(t, f.availabilityStore.Truncate(1, 2))
+	require.ErrorIs(t, f.runVerify(t), db.ErrInconsistentDB)
+
+	// They are restored from the CometBFT block store.
+	require.NoError(t, f.runVerify(t, "--repair"))
+	sidecars, err := f.availabilityStore.GetBlobSidecars(1)
+	require.NoError(t, err)
+	require.Equal(t, f.sidecars, sidecars)
+	require.NoError(t, f.runVerify(t))
+}
+
+func TestVerifyDeposits(t *testing.T) {
+	t.Parallel()
+	f := newVerifyFixture(t)
+
+	// A deposit store behind the beacon state cannot be repaired.
+	f.depositStore = deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	require.ErrorIs(t, f.runVerify(t, "--repair"), db.ErrInconsistentDB)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+	"reflect"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// diffStates returns a human readable line for every field of the beacon
+// state that differs between the stored and the replayed state. Lists are
+// compared element by element so that the offending index is reported.
+func diffStates(stored, replayed *ctypes.BeaconState) []string {
+	var diffs []string
+	diffs = appendDiff(diffs, "genesis_validators_root", stored.GenesisValidatorsRoot, replayed.GenesisValidatorsRoot)
+	diffs = appendDiff(diffs, "slot", stored.Slot, replayed.Slot)
+	diffs = appendDiff(diffs, "fork", stored.Fork, replayed.Fork)
+	diffs = appendDiff(diffs, "latest_block_header", stored.LatestBlockHeader, replayed.LatestBlockHeader)
+	diffs = appendListDiff(diffs, "block_roots", stored.BlockRoots, replayed.BlockRoots)
+	diffs = appendListDiff(diffs, "state_roots", stored.StateRoots, replayed.StateRoots)
+	diffs = appendDiff(diffs, "eth1_data", stored.Eth1Data, replayed.Eth1Data)
+	diffs = appendDiff(diffs, "eth1_deposit_index", stored.Eth1DepositIndex, replayed.Eth1DepositIndex)
+	diffs = appendDiff(
+		diffs, "latest_execution_payload_header",
+		stored.LatestExecutionPayloadHeader, replayed.LatestExecutionPayloadHeader,
+	)
+	diffs = appendListDiff(diffs, "validators", stored.Validators, replayed.Validators)
+	diffs = appendListDiff(diffs, "balances", stored.Balances, replayed.Balances)
+	diffs = appendListDiff(diffs, "randao_mixes", stored.RandaoMixes, replayed.RandaoMixes)
+	diffs = appendDiff(diffs, "next_withdrawal_index", stored.NextWithdrawalIndex, replayed.NextWithdrawalIndex)
+	diffs = appendDiff(
+		diffs, "next_withdrawal_validator_index",
+		stored.NextWithdrawalValidatorIndex, replayed.NextWithdrawalValidatorIndex,
+	)
+	diffs = appendListDiff(diffs, "slashings", stored.Slashings, replayed.Slashings)
+	diffs = appendDiff(diffs, "total_slashing", stored.TotalSlashing, replayed.TotalSlashing)
+	diffs = appendListDiff(
+		diffs, "pending_partial_withdrawals",
+		stored.PendingPartialWithdrawals, replayed.PendingPartialWithdrawals,
+	)
+	return diffs
+}
+
+// appendDiff appends a line to diffs if stored and replayed differ.
+func appendDiff(diffs []string, field string, stored, replayed any) []string {
+	if reflect.DeepEqual(stored, replayed) {
+		return diffs
+	}
+	return append(diffs, fmt.Sprintf("%s: stored %s, replayed %s", field, format(stored), format(replayed)))
+}
+
+// appendListDiff appends a line to diffs for every index at which stored and
+// replayed differ, as well as for a difference in length.
+func appendListDiff[T any](diffs []string, field string, stored, replayed []T) []string {
+	if len(stored) != len(replayed) {
+		diffs = append(diffs, fmt.Sprintf(
+			"%s: stored length %d, replayed length %d", field, len(stored), len(replayed),
+		))
+	}
+	for i := range min(len(stored), len(replayed)) {
+		diffs = appendDiff(diffs, fmt.Sprintf("%s[%d]", field, i), stored[i], replayed[i])
+	}
+	return diffs
+}
+
+// format renders a state field as JSON, falling back to its default format.
+func format(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(bz)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+)
+
+// acceptingEngine is an execution engine that considers every payload valid.
+// Replayed blocks have already been accepted by the network, so the execution
+// layer is not consulted and only the consensus state transition is exercised.
+type acceptingEngine struct{}
+
+// NotifyForkchoiceUpdate accepts the forkchoice update without building a
+// payload.
+func (acceptingEngine) NotifyForkchoiceUpdate(
+	context.Context,
+	*ctypes.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, error) {
+	return nil, nil //nolint:nilnil // no payload is ever built.
+}
+
+// NotifyNewPayload accepts the payload.
+func (acceptingEngine) NotifyNewPayload(context.Context, ctypes.NewPayloadRequest, bool) error {
+	return nil
+}
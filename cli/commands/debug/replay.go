@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+)
+
+var (
+	// ErrInvalidReplayRange is returned when the requested range of heights
+	// cannot be replayed.
+	ErrInvalidReplayRange = errors.New("invalid replay range")
+)
+
+// NewReplayCmd creates a new command replaying stored blocks on top of a
+// committed state.
+//
+//nolint:lll // reads better if long description is one line
+func NewReplayCmd(chainSpecCreator servertypes.ChainSpecCreator, appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replays stored blocks on top of a committed state without modifying the node's data",
+		Long:  `This command loads the beacon state committed at height --from and replays the blocks stored by CometBFT up to height --to through the state transition, without consulting the execution client. After every block the replayed state root is checked against the one committed to by the block. On mismatch, the replayed state is diffed field by field against the state the node stored at that height and the command fails. Deposits are read from the node's live deposit store, which holds the deposits enqueued up to its latest state but may lack the ones preceding its deposit tree snapshot, e.g. when the node was started from a snapshot; replaying from a state whose deposits it cannot serve is refused up front. The node must be stopped and its data is never modified.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			if to == 0 {
+				to = from + 1
+			}
+			if from <= 0 || to <= from {
+				return errors.Wrapf(ErrInvalidReplayRange, "from %d, to %d", from, to)
+			}
+
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+			appDB, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+			blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(blockDB)
+			defer blockStore.Close()
+			if to > blockStore.Height() {
+				return errors.Wrapf(
+					ErrInvalidReplayRange, "to %d is above the latest stored block %d", to, blockStore.Height(),
+				)
+			}
+
+			r := &replayer{
+				cmd:        cmd,
+				logger:     logger,
+				chainSpec:  chainSpec,
+				app:        appCreator(logger, appDB, nil, cfg, v),
+				blockStore: blockStore,
+			}
+			return r.replay(from, to)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "Height of the committed state to start replaying from")
+	cmd.Flags().Int64(flagTo, 0, "Height of the last block to replay (defaults to --from + 1)")
+	_ = cmd.MarkFlagRequired(flagFrom)
+	return cmd
+}
+
+// replayer replays stored blocks through the state transition.
+type replayer struct {
+	cmd        *cobra.Command
+	logger     *phuslu.Logger
+	chainSpec  chain.Spec
+	app        types.Node
+	blockStore *store.BlockStore
+}
+
+// replay loads the state committed at height from and applies the stored
+// blocks up to height to, verifying the state root after every block.
+func (r *replayer) replay(from, to int64) error {
+	ctx, st, err := r.stateAt(from)
+	if err != nil {
+		return err
+	}
+	if err = r.checkDeposits(ctx, st, from); err != nil {
+		return err
+	}
+	sp := core.NewStateProcessor(
+		r.logger.With("service", "state-processor"),
+		r.chainSpec,
+		acceptingEngine{},
+		r.app.StorageBackend().DepositStore(),
+		// Only signature verification is needed, which requires no key.
+		&signer.LegacySigner{},
+		crypto.GetAddressFromPubKey,
+		metrics.NewNoOpTelemetrySink(),
+	)
+
+	for height := from + 1; height <= to; height++ {
+		blk, txCtx, err := r.loadBlock(ctx, height)
+		if err != nil {
+			return err
+		}
+		if _, err = sp.Transition(txCtx, st, blk); err != nil {
+			return errors.Wrapf(err, "failed to replay block at height %d", height)
+		}
+
+		replayedRoot := st.HashTreeRoot()
+		if replayedRoot == blk.GetStateRoot() {
+			r.logger.Info(
+				"Replayed block", "height", height, "slot", blk.GetSlot(), "state_root", replayedRoot,
+			)
+			continue
+		}
+
+		r.logger.Error(
+			"Replayed state root does not match the block",
+			"height", height,
+			"slot", blk.GetSlot(),
+			"block_state_root", blk.GetStateRoot(),
+			"replayed_state_root", replayedRoot,
+		)
+		if err = r.printDiff(st, height); err != nil {
+			return err
+		}
+		return errors.Wrapf(core.ErrStateRootMismatch, "at height %d", height)
+	}
+	return nil
+}
+
+// checkDeposits checks that the deposit store serves the deposits preceding
+// the state committed at the given height. The store is the node's live one,
+// so the deposits of a historical state may be missing from it.
+func (r *replayer) checkDeposits(ctx sdk.Context, st *statedb.StateDB, height int64) error {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	if err = core.ValidateNonGenesisDeposits(
+		ctx, st, r.app.StorageBackend().DepositStore(), 0, nil, eth1Data.DepositRoot,
+	); err != nil {
+		return errors.Wrapf(
+			ErrInvalidReplayRange, "deposit store cannot serve the state at height %d: %v", height, err,
+		)
+	}
+	return nil
+}
+
+// stateAt returns the beacon state committed at the given height, backed by a
+// cache so that the replay never writes to the node's data.
+func (r *replayer) stateAt(height int64) (sdk.Context, *statedb.StateDB, error) {
+	cms, err := r.app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, nil, errors.Wrapf(err, "failed to load state at height %d", height)
+	}
+	ctx := sdk.NewContext(
+		cms, false, servercmtlog.WrapSDKLogger(r.logger),
+	).WithContext(r.cmd.Context())
+	return ctx, r.app.StorageBackend().StateFromContext(ctx), nil
+}
+
+// loadBlock decodes the beacon block stored at the given height along with
+// the transition context FinalizeBlock used to apply it.
+func (r *replayer) loadBlock(
+	ctx sdk.Context,
+	height int64,
+) (*ctypes.BeaconBlock, *transition.Context, error) {
+	block, _ := r.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, errors.Wrapf(ErrInvalidReplayRange, "no block stored at height %d", height)
+	}
+	consensusTime := math.U64(block.Time.Unix()) // #nosec G115
+	txs, err := envelope.DecodeTxs(
+		block.Txs.ToSliceOfBytes(),
+		r.chainSpec.ConsensusTxCompression(consensusTime),
+		envelope.MaxDecodedTxSize,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to decode txs at height %d", height)
+	}
+	signedBlk, err := encoding.UnmarshalBeaconBlockFromABCIRequest(
+		txs,
+		blockchain.BeaconBlockTxIndex,
+		r.chainSpec.ActiveForkVersionForTimestamp(consensusTime),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to decode block at height %d", height)
+	}
+
+	txCtx := transition.NewTransitionCtx(
+		ctx,
+		consensusTime,
+		block.ProposerAddress,
+	).
+		WithVerifyPayload(true).
+		WithVerifyRandao(false).
+		WithVerifyResult(false).
+		WithMeterGas(false)
+	return signedBlk.GetBeaconBlock(), txCtx, nil
+}
+
+// printDiff prints the fields of the replayed state that differ from the state
+// stored at the given height.
+func (r *replayer) printDiff(replayed *statedb.StateDB, height int64) error {
+	_, stored, err := r.stateAt(height)
+	if err != nil {
+		return err
+	}
+	storedState, err := stored.GetMarshallable()
+	if err != nil {
+		return err
+	}
+	replayedState, err := replayed.GetMarshallable()
+	if err != nil {
+		return err
+	}
+
+	diffs := diffStates(storedState, replayedState)
+	if len(diffs) == 0 {
+		r.cmd.Printf("replayed state at height %d matches the stored state\n", height)
+		return nil
+	}
+	r.cmd.Printf("replayed state at height %d differs from the stored state:\n", height)
+	for _, diff := range diffs {
+		r.cmd.Println("  " + diff)
+	}
+	return nil
+}
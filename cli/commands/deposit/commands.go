@@ -41,6 +41,7 @@ func Commands(chainSpecCreator servertypes.ChainSpecCreator, appCreator serverty
 		GetCreateValidatorCmd(chainSpecCreator),
 		GetValidatorKeysCmd(),
 		GetDBCheckCmd(appCreator),
+		GetImportSnapshotCmd(appCreator),
 	)
 
 	return cmd
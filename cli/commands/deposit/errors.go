@@ -42,4 +42,9 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrEmptyDepositSnapshot is returned when the deposit snapshot file
+	// has no data.
+	ErrEmptyDepositSnapshot = errors.New(
+		"deposit snapshot is empty")
 )
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// GetImportSnapshotCmd returns a command for initializing the deposit store
+// from an EIP-4881 deposit tree snapshot, as served by the
+// /eth/v1/beacon/deposit_snapshot endpoint of a trusted node.
+func GetImportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-snapshot [snapshot-file]",
+		Short: "Initializes an empty deposit store from an EIP-4881 deposit tree snapshot",
+		Long: `Initializes an empty deposit store from an EIP-4881 deposit tree snapshot, so that deposits
+preceding the snapshot do not need to be fetched from the execution layer. The snapshot file is the JSON
+response of the /eth/v1/beacon/deposit_snapshot endpoint of a trusted node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var resp beacontypes.DepositSnapshotResponse
+			if err = json.Unmarshal(bz, &resp); err != nil {
+				return err
+			}
+			if resp.Data == nil {
+				return ErrEmptyDepositSnapshot
+			}
+
+			// Create the application from home directory configs and data.
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, db, nil, cfg, v)
+			depositStore := app.StorageBackend().DepositStore()
+
+			snapshot := beacontypes.DepositSnapshotToConsensus(resp.Data)
+			if err = depositStore.InitFromSnapshot(cmd.Context(), snapshot); err != nil {
+				return errors.Join(err, depositStore.Close())
+			}
+			if err = depositStore.Close(); err != nil {
+				return err
+			}
+
+			logger.Info(
+				"✅ Deposit store initialized from snapshot",
+				"deposit_count", snapshot.DepositCount,
+				"deposit_root", snapshot.DepositRoot,
+			)
+			return nil
+		},
+	}
+
+	return cmd
+}
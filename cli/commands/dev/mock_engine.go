@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dev
+
+import (
+	"os/signal"
+	"syscall"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	enginemock "github.com/berachain/beacon-kit/execution/engine-mock"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAddress   = "addr"
+	flagJWTSecret = "jwt-secret"
+	flagStatus    = "status"
+	flagLatency   = "latency"
+
+	defaultMockEngineAddress = "127.0.0.1:8551"
+)
+
+// NewMockEngineCmd creates a new command serving an in-process mock execution
+// client.
+//
+//nolint:lll // reads better if long description is one line
+func NewMockEngineCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mock-engine [eth/genesis/file.json]",
+		Short: "Serves a mock execution client implementing the Engine API",
+		Long:  `This command serves a mock execution client on top of the given eth1 genesis file. It implements the Engine API and the deposit contract logs over a deterministic fake chain without transactions, so that a node can run without a real execution client. Faults can be injected with the --status and --latency flags.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+
+			genesisBz, err := afero.ReadFile(afero.NewOsFs(), args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to read eth1 genesis file")
+			}
+			genesis := &gethprimitives.Genesis{}
+			if err = genesis.UnmarshalJSON(genesisBz); err != nil {
+				return errors.Wrap(err, "failed to unmarshal eth1 genesis")
+			}
+
+			cfg := enginemock.Config{
+				Genesis:         genesis,
+				DepositContract: chainSpec.DepositContractAddress(),
+			}
+			if cfg.JWTSecret, err = getJWTSecret(cmd); err != nil {
+				return err
+			}
+			if cfg.Faults.Status, err = cmd.Flags().GetString(flagStatus); err != nil {
+				return err
+			}
+			if cfg.Faults.Latency, err = cmd.Flags().GetDuration(flagLatency); err != nil {
+				return err
+			}
+			addr, err := cmd.Flags().GetString(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			server, err := enginemock.New(cfg)
+			if err != nil {
+				return err
+			}
+			if err = server.Start(addr); err != nil {
+				return err
+			}
+			logger.Info(
+				"Serving mock execution client 🧪",
+				"url", server.URL(),
+				"chain_id", server.ChainID(),
+				"head", server.Forkchoice().HeadBlockHash,
+			)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+			<-ctx.Done()
+			return server.Stop()
+		},
+	}
+
+	cmd.Flags().String(flagAddress, defaultMockEngineAddress, "Address to serve the mock execution client on")
+	cmd.Flags().String(flagJWTSecret, "", "Optional path to the JWT secret required to authenticate the calls")
+	cmd.Flags().String(flagStatus, "", "Payload status to return instead of the actual one (SYNCING, ACCEPTED or INVALID)")
+	cmd.Flags().Duration(flagLatency, 0, "Latency added to every call")
+	return cmd
+}
+
+// getJWTSecret loads the JWT secret from the path given by the flag, if any.
+func getJWTSecret(cmd *cobra.Command) (*jwt.Secret, error) {
+	path, err := cmd.Flags().GetString(flagJWTSecret)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	return components.LoadJWTFromFile(path)
+}
@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/afero"
+)
+
+const (
+	// elGenesisFile is the name of the eth1 genesis shared by all the nodes,
+	// with the deposit contract storage set.
+	elGenesisFile = "eth-genesis.json"
+	// jwtSecretFile is the name of the JWT secret shared by all the nodes
+	// and their execution clients.
+	jwtSecretFile = "jwt.hex"
+	// manifestFile is the name of the file recording the layout the devnet
+	// was generated with.
+	manifestFile = "devnet.json"
+)
+
+// manifest records the layout a devnet was generated with.
+type manifest struct {
+	// Validators is the number of validators of the devnet.
+	Validators int `json:"validators"`
+}
+
+// network describes the layout of a local devnet.
+type network struct {
+	// dir is the directory holding the home directories of all the nodes.
+	dir string
+	// chainID is the chain ID of the devnet.
+	chainID string
+	// nodes are the validator nodes of the devnet, in index order.
+	nodes []*devnetNode
+}
+
+// devnetNode describes a single validator node of the devnet.
+type devnetNode struct {
+	// moniker is the name of the node.
+	moniker string
+	// cfg is the CometBFT configuration of the node, rooted at its home.
+	cfg *cmtcfg.Config
+	// apiAddress is the address the node API is served on.
+	apiAddress string
+}
+
+// newNetwork lays out a devnet of the given number of validators, assigning
+// sequential ports starting from the given base ports.
+func newNetwork(
+	dir, chainID string, validators int, p2pPort, rpcPort, apiPort int,
+) *network {
+	n := &network{
+		dir:     dir,
+		chainID: chainID,
+		nodes:   make([]*devnetNode, validators),
+	}
+	for i := range validators {
+		moniker := fmt.Sprintf("node%d", i)
+		cfg := cometbft.DefaultConfig()
+		cfg.SetRoot(filepath.Join(dir, moniker))
+		cfg.Moniker = moniker
+		cfg.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", p2pPort+i)
+		cfg.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", rpcPort+i)
+		// All the nodes share the same host. CometBFT nodes only peer over
+		// TCP, so they are connected through the loopback interface with
+		// peer exchange disabled, which keeps the devnet to its own nodes.
+		cfg.P2P.AddrBookStrict = false
+		cfg.P2P.AllowDuplicateIP = true
+		cfg.P2P.PexReactor = false
+		// CometBFT metrics are registered against the global prometheus
+		// registry, which cannot be shared by several nodes in one process.
+		cfg.Instrumentation.Prometheus = false
+
+		n.nodes[i] = &devnetNode{
+			moniker:    moniker,
+			cfg:        cfg,
+			apiAddress: fmt.Sprintf("127.0.0.1:%d", apiPort+i),
+		}
+	}
+	return n
+}
+
+// elGenesisPath returns the path of the eth1 genesis shared by all the nodes.
+func (n *network) elGenesisPath() string {
+	return filepath.Join(n.dir, elGenesisFile)
+}
+
+// jwtSecretPath returns the path of the JWT secret shared by all the nodes.
+func (n *network) jwtSecretPath() string {
+	return filepath.Join(n.dir, jwtSecretFile)
+}
+
+// manifestPath returns the path of the devnet manifest.
+func (n *network) manifestPath() string {
+	return filepath.Join(n.dir, manifestFile)
+}
+
+// initialized returns whether the devnet has already been generated, in which
+// case it must have been generated with as many validators as requested.
+func (n *network) initialized() (bool, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), n.manifestPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read devnet manifest")
+	}
+	var m manifest
+	if err = json.Unmarshal(bz, &m); err != nil {
+		return false, errors.Wrap(err, "failed to unmarshal devnet manifest")
+	}
+	if m.Validators != len(n.nodes) {
+		return false, errors.Wrapf(ErrValidatorsMismatch,
+			"devnet in %s has %d validators, %d requested", n.dir, m.Validators, len(n.nodes),
+		)
+	}
+	return true, nil
+}
+
+// generate creates the keys of every node, a beacon genesis depositing the
+// max effective balance for each of them and the matching eth1 genesis.
+func (n *network) generate(
+	cs chain.Spec,
+	mm GenesisProvider,
+	elGenesisPath string,
+	withdrawalAddress common.ExecutionAddress,
+) error {
+	fs := afero.NewOsFs()
+	for _, node := range n.nodes {
+		cmtcfg.EnsureRoot(node.cfg.RootDir)
+		if _, _, err := genutil.InitializeNodeValidatorFilesFromMnemonic(
+			node.cfg, "", crypto.CometBLSType,
+		); err != nil {
+			return errors.Wrapf(err, "failed to initialize %s keys", node.moniker)
+		}
+	}
+
+	// The genesis is assembled in the home of the first node, then copied
+	// over to the other ones.
+	first := n.nodes[0].cfg
+	appState, err := json.MarshalIndent(mm.DefaultGenesis(cs), "", " ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal default genesis state")
+	}
+	appGenesis := &genutiltypes.AppGenesis{
+		AppName:       version.AppName,
+		AppVersion:    version.Version,
+		ChainID:       n.chainID,
+		AppState:      appState,
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cometbft.DefaultConsensusParams(crypto.CometBLSType),
+		},
+	}
+	if err = genutil.ExportGenesisFile(appGenesis, first.GenesisFile()); err != nil {
+		return errors.Wrap(err, "failed to export genesis file")
+	}
+
+	// Deposits left over by an interrupted generation are discarded.
+	depositsDir := filepath.Join(first.RootDir, "config", "premined-deposits")
+	if err = fs.RemoveAll(depositsDir); err != nil {
+		return err
+	}
+	if err = fs.MkdirAll(depositsDir, os.ModePerm); err != nil {
+		return err
+	}
+	for _, node := range n.nodes {
+		blsSigner := signer.NewBLSSigner(
+			node.cfg.PrivValidatorKeyFile(), node.cfg.PrivValidatorStateFile(),
+		)
+		if err = genesis.AddGenesisDeposit(
+			cs,
+			node.cfg,
+			blsSigner,
+			cs.MaxEffectiveBalance(),
+			withdrawalAddress,
+			filepath.Join(depositsDir, fmt.Sprintf("premined-deposit-%s.json", node.moniker)),
+		); err != nil {
+			return errors.Wrapf(err, "failed to add %s genesis deposit", node.moniker)
+		}
+	}
+	if err = genesis.CollectGenesisDeposits(first); err != nil {
+		return err
+	}
+
+	// SetDepositStorage writes the updated eth1 genesis in the home of the
+	// first node, which becomes the one shared by all the nodes.
+	if err = genesis.SetDepositStorage(cs, first, elGenesisPath, false); err != nil {
+		return err
+	}
+	if err = fs.Rename(
+		filepath.Join(first.RootDir, filepath.Base(elGenesisPath)), n.elGenesisPath(),
+	); err != nil {
+		return err
+	}
+	if err = genesis.AddExecutionPayload(cs, n.elGenesisPath(), first); err != nil {
+		return err
+	}
+
+	genesisBz, err := afero.ReadFile(fs, first.GenesisFile())
+	if err != nil {
+		return err
+	}
+	for _, node := range n.nodes[1:] {
+		//#nosec:G306 // genesis is public.
+		if err = afero.WriteFile(fs, node.cfg.GenesisFile(), genesisBz, 0o644); err != nil {
+			return err
+		}
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return err
+	}
+	if err = afero.WriteFile(fs, n.jwtSecretPath(), []byte(secret.Hex()), 0o600); err != nil {
+		return err
+	}
+
+	// The manifest is written last, so that an interrupted generation is
+	// started over on the next run.
+	manifestBz, err := json.Marshal(manifest{Validators: len(n.nodes)})
+	if err != nil {
+		return err
+	}
+	//#nosec:G306 // manifest is public.
+	return afero.WriteFile(fs, n.manifestPath(), manifestBz, 0o644)
+}
+
+// connect sets every node as a persistent peer of the other ones and writes
+// the CometBFT configuration of each node in its home.
+func (n *network) connect() error {
+	peers := make([]string, len(n.nodes))
+	for i, node := range n.nodes {
+		nodeKey, err := p2p.LoadNodeKey(node.cfg.NodeKeyFile())
+		if err != nil {
+			return errors.Wrapf(err, "failed to load %s node key", node.moniker)
+		}
+		peers[i] = p2p.IDAddressString(
+			nodeKey.ID(), strings.TrimPrefix(node.cfg.P2P.ListenAddress, "tcp://"),
+		)
+	}
+	for i, node := range n.nodes {
+		others := make([]string, 0, len(peers)-1)
+		others = append(others, peers[:i]...)
+		others = append(others, peers[i+1:]...)
+		node.cfg.P2P.PersistentPeers = strings.Join(others, ",")
+		cmtcfg.WriteConfigFile(
+			filepath.Join(node.cfg.RootDir, "config", "config.toml"), node.cfg,
+		)
+	}
+	return nil
+}
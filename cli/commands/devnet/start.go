@@ -0,0 +1,378 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/errors"
+	enginemock "github.com/berachain/beacon-kit/execution/engine-mock"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	flagValidators        = "validators"
+	flagDir               = "dir"
+	flagChainID           = "chain-id"
+	flagEthGenesis        = "eth-genesis"
+	flagWithdrawalAddress = "withdrawal-address"
+	flagEngineURLs        = "engine-urls"
+	flagP2PPort           = "p2p-port"
+	flagRPCPort           = "rpc-port"
+	flagAPIPort           = "api-port"
+
+	defaultValidators        = 4
+	defaultDir               = "devnet"
+	defaultChainID           = "devnet-beacon-80087"
+	defaultWithdrawalAddress = "0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4"
+	defaultP2PPort           = 26656
+	defaultRPCPort           = 26756
+	defaultAPIPort           = 3500
+)
+
+var (
+	// ErrNoValidators is returned when the devnet is started without any
+	// validator.
+	ErrNoValidators = errors.New("devnet requires at least one validator")
+	// ErrEngineURLsMismatch is returned when the number of execution client
+	// URLs does not match the number of validators.
+	ErrEngineURLsMismatch = errors.New(
+		"number of engine urls does not match number of validators",
+	)
+	// ErrValidatorsMismatch is returned when an existing devnet is restarted
+	// with a different number of validators.
+	ErrValidatorsMismatch = errors.New(
+		"number of validators does not match the existing devnet",
+	)
+)
+
+// NewStartCmd creates a new command running a local devnet in process.
+//
+//nolint:lll // reads better if long description is one line
+func NewStartCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+	appCreator servertypes.AppCreator,
+	mm GenesisProvider,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Runs a local devnet of N validators in a single process",
+		Long:  `This command runs a local devnet of N validators within this process. On first run it generates the keys and home directory of every node under --dir, along with a beacon genesis depositing the max effective balance for each validator and the matching eth1 genesis derived from --eth-genesis; later runs restart the existing devnet, which must have as many validators. Each node runs CometBFT in process; CometBFT nodes only peer over TCP, so they connect to each other over the loopback interface with peer exchange disabled. Each node is backed by an in-process mock execution client unless --engine-urls are given. The node API of the i-th node is served on --api-port + i.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			v := clicontext.GetViperFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+
+			n, err := networkFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			initialized, err := n.initialized()
+			if err != nil {
+				return err
+			}
+			if !initialized {
+				if err = generateFromFlags(cmd, n, chainSpec, mm); err != nil {
+					return err
+				}
+				logger.Info("Generated devnet", "dir", n.dir, "validators", len(n.nodes))
+			}
+			if err = n.connect(); err != nil {
+				return err
+			}
+
+			engineURLs, err := cmd.Flags().GetStringSlice(flagEngineURLs)
+			if err != nil {
+				return err
+			}
+			if len(engineURLs) == 0 {
+				var engines []*enginemock.Server
+				if engines, err = startMockEngines(n, chainSpec); err != nil {
+					return err
+				}
+				defer func() {
+					for _, engine := range engines {
+						//#nosec:G104 // nothing to do on shutdown.
+						_ = engine.Stop()
+					}
+				}()
+				for _, engine := range engines {
+					engineURLs = append(engineURLs, engine.URL())
+				}
+			}
+			if len(engineURLs) != len(n.nodes) {
+				return ErrEngineURLsMismatch
+			}
+
+			return runNodes(cmd, logger, v, appCreator, n, engineURLs)
+		},
+	}
+
+	cmd.Flags().Int(flagValidators, defaultValidators, "Number of validators of the devnet")
+	cmd.Flags().String(flagDir, "", "Directory holding the devnet nodes (default \"<home>/devnet\")")
+	cmd.Flags().String(flagChainID, defaultChainID, "Chain ID of the devnet genesis")
+	cmd.Flags().String(flagEthGenesis, "", "Eth1 genesis file the devnet genesis is derived from, required on first run")
+	cmd.Flags().String(flagWithdrawalAddress, defaultWithdrawalAddress, "Withdrawal address of the genesis validators")
+	cmd.Flags().StringSlice(flagEngineURLs, nil, "Execution client URLs, one per validator, authenticated with <dir>/jwt.hex (default in-process mock execution clients)")
+	cmd.Flags().Int(flagP2PPort, defaultP2PPort, "CometBFT P2P port of the first node")
+	cmd.Flags().Int(flagRPCPort, defaultRPCPort, "CometBFT RPC port of the first node")
+	cmd.Flags().Int(flagAPIPort, defaultAPIPort, "Node API port of the first node")
+	return cmd
+}
+
+// networkFromFlags lays out the devnet described by the command flags.
+func networkFromFlags(cmd *cobra.Command) (*network, error) {
+	validators, err := cmd.Flags().GetInt(flagValidators)
+	if err != nil {
+		return nil, err
+	}
+	if validators < 1 {
+		return nil, ErrNoValidators
+	}
+	dir, err := cmd.Flags().GetString(flagDir)
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		dir = filepath.Join(clicontext.GetConfigFromCmd(cmd).RootDir, defaultDir)
+	}
+	chainID, err := cmd.Flags().GetString(flagChainID)
+	if err != nil {
+		return nil, err
+	}
+	p2pPort, err := cmd.Flags().GetInt(flagP2PPort)
+	if err != nil {
+		return nil, err
+	}
+	rpcPort, err := cmd.Flags().GetInt(flagRPCPort)
+	if err != nil {
+		return nil, err
+	}
+	apiPort, err := cmd.Flags().GetInt(flagAPIPort)
+	if err != nil {
+		return nil, err
+	}
+	return newNetwork(dir, chainID, validators, p2pPort, rpcPort, apiPort), nil
+}
+
+// generateFromFlags generates the devnet genesis from the command flags.
+func generateFromFlags(
+	cmd *cobra.Command, n *network, chainSpec chain.Spec, mm GenesisProvider,
+) error {
+	elGenesisPath, err := cmd.Flags().GetString(flagEthGenesis)
+	if err != nil {
+		return err
+	}
+	if elGenesisPath == "" {
+		return fmt.Errorf("--%s is required to generate the devnet", flagEthGenesis)
+	}
+	withdrawalAddress, err := cmd.Flags().GetString(flagWithdrawalAddress)
+	if err != nil {
+		return err
+	}
+	return n.generate(
+		chainSpec, mm, elGenesisPath,
+		common.NewExecutionAddressFromHex(withdrawalAddress),
+	)
+}
+
+// startMockEngines starts one mock execution client per node on top of the
+// devnet eth1 genesis.
+func startMockEngines(n *network, chainSpec chain.Spec) ([]*enginemock.Server, error) {
+	genesisBz, err := afero.ReadFile(afero.NewOsFs(), n.elGenesisPath())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read eth1 genesis file")
+	}
+	secret, err := components.LoadJWTFromFile(n.jwtSecretPath())
+	if err != nil {
+		return nil, err
+	}
+
+	engines := make([]*enginemock.Server, 0, len(n.nodes))
+	for range n.nodes {
+		var engine *enginemock.Server
+		if engine, err = newMockEngine(genesisBz, secret, chainSpec); err != nil {
+			break
+		}
+		engines = append(engines, engine)
+	}
+	if err != nil {
+		for _, engine := range engines {
+			//#nosec:G104 // already failing.
+			_ = engine.Stop()
+		}
+		return nil, err
+	}
+	return engines, nil
+}
+
+// newMockEngine starts a mock execution client on a random local port.
+func newMockEngine(
+	genesisBz []byte, secret *jwt.Secret, chainSpec chain.Spec,
+) (*enginemock.Server, error) {
+	// Every engine gets its own copy of the genesis.
+	genesis := &gethprimitives.Genesis{}
+	if err := genesis.UnmarshalJSON(genesisBz); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	engine, err := enginemock.New(enginemock.Config{
+		Genesis:         genesis,
+		DepositContract: chainSpec.DepositContractAddress(),
+		JWTSecret:       secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = engine.Start("127.0.0.1:0"); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// runNodes builds and starts every node of the devnet, and blocks until they
+// are all shut down, which happens upon SIGINT or SIGTERM. A node failing
+// stops all the other ones.
+func runNodes(
+	cmd *cobra.Command,
+	logger *phuslu.Logger,
+	v *viper.Viper,
+	appCreator servertypes.AppCreator,
+	n *network,
+	engineURLs []string,
+) error {
+	apps, err := buildNodes(cmd, logger, v, appCreator, n, engineURLs)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+	for i, app := range apps {
+		node := n.nodes[i]
+		logger.Info(
+			"Starting devnet node",
+			"node", node.moniker,
+			"api", node.apiAddress,
+			"rpc", node.cfg.RPC.ListenAddress,
+			"engine", engineURLs[i],
+		)
+		g.Go(func() error {
+			if errStart := app.Start(ctx); errStart != nil {
+				return errors.Wrapf(errStart, "%s failed", node.moniker)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// buildNodes opens the database and builds the application of every node of
+// the devnet. On failure, the databases opened so far are closed.
+func buildNodes(
+	cmd *cobra.Command,
+	logger *phuslu.Logger,
+	v *viper.Viper,
+	appCreator servertypes.AppCreator,
+	n *network,
+	engineURLs []string,
+) ([]types.Node, error) {
+	var (
+		apps      = make([]types.Node, 0, len(n.nodes))
+		databases = make([]dbm.DB, 0, len(n.nodes))
+		err       error
+	)
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, database := range databases {
+			//#nosec:G104 // already failing.
+			_ = database.Close()
+		}
+	}()
+
+	for i, node := range n.nodes {
+		var appOpts *viper.Viper
+		if appOpts, err = nodeAppOptions(cmd, v, n, node, engineURLs[i]); err != nil {
+			return nil, err
+		}
+		var database dbm.DB
+		if database, err = db.OpenDB(node.cfg.RootDir, dbm.PebbleDBBackend); err != nil {
+			return nil, err
+		}
+		databases = append(databases, database)
+		apps = append(apps, appCreator(
+			logger.With("node", node.moniker), database, nil, node.cfg, appOpts,
+		))
+	}
+	return apps, nil
+}
+
+// nodeAppOptions returns the application options of a node, which are the
+// ones of the command with the node specific settings overridden.
+func nodeAppOptions(
+	cmd *cobra.Command,
+	v *viper.Viper,
+	n *network,
+	node *devnetNode,
+	engineURL string,
+) (*viper.Viper, error) {
+	// The app.toml of the command home is only merged into the command
+	// options if it existed before the command ran, so it is read again.
+	appOpts := viper.New()
+	appOpts.SetConfigFile(
+		filepath.Join(clicontext.GetConfigFromCmd(cmd).RootDir, "config", "app.toml"),
+	)
+	if err := appOpts.MergeInConfig(); err != nil {
+		return nil, err
+	}
+	if err := appOpts.MergeConfigMap(v.AllSettings()); err != nil {
+		return nil, err
+	}
+	appOpts.Set(sdkflags.FlagHome, node.cfg.RootDir)
+	appOpts.Set(flags.RPCDialURL, engineURL)
+	appOpts.Set(flags.JWTSecretPath, n.jwtSecretPath())
+	appOpts.Set(flags.PrivValidatorKeyFile, node.cfg.PrivValidatorKeyFile())
+	appOpts.Set(flags.PrivValidatorStateFile, node.cfg.PrivValidatorStateFile())
+	appOpts.Set(flags.NodeAPIEnabled, true)
+	appOpts.Set(flags.NodeAPIAddress, node.apiAddress)
+	return appOpts, nil
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package devnet_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/store"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/devnet"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config/spec"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+var errNodeFailed = errors.New("node failed")
+
+// testNode is a node running until its context is cancelled, unless it fails
+// upon starting.
+type testNode struct {
+	moniker string
+	fail    bool
+	nodes   *testNodes
+}
+
+func (n *testNode) CommitMultiStore() store.CommitMultiStore { return nil }
+
+func (n *testNode) StorageBackend() blockchain.StorageBackend { return nil }
+
+func (n *testNode) Start(ctx context.Context) error {
+	n.nodes.record(n.moniker, true)
+	defer n.nodes.record(n.moniker, false)
+	if n.fail {
+		return errNodeFailed
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// testNodes tracks the nodes built by the devnet.
+type testNodes struct {
+	mu      sync.Mutex
+	failing string
+	running map[string]bool
+	started int
+}
+
+func (ns *testNodes) record(moniker string, running bool) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	ns.running[moniker] = running
+	if running {
+		ns.started++
+	}
+}
+
+func (ns *testNodes) runningCount() int {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	count := 0
+	for _, running := range ns.running {
+		if running {
+			count++
+		}
+	}
+	return count
+}
+
+func (ns *testNodes) startedCount() int {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	return ns.started
+}
+
+func (ns *testNodes) appCreator(
+	_ *phuslu.Logger, database dbm.DB, _ io.Writer, cfg *cmtcfg.Config, _ servertypes.AppOptions,
+) types.Node {
+	// The test nodes never start the services closing the database.
+	_ = database.Close()
+	return &testNode{moniker: cfg.Moniker, fail: cfg.Moniker == ns.failing, nodes: ns}
+}
+
+// runStart runs the devnet start command in a home holding an empty app.toml.
+func runStart(ctx context.Context, t *testing.T, ns *testNodes, homeDir string, args ...string) error {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, homeDir)
+	ctx = context.WithValue(ctx, clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(io.Discard, nil))
+
+	cmd := devnet.NewStartCmd(
+		func(servertypes.AppOptions) (chain.Spec, error) { return spec.DevnetChainSpec() },
+		ns.appCreator,
+		&cometbft.Service{},
+	)
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+	homeDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config", "app.toml"), nil, 0o600))
+	devnetDir := filepath.Join(homeDir, "devnet")
+	elGenesis, err := filepath.Abs("../../../testing/files/eth-genesis.json")
+	require.NoError(t, err)
+	args := []string{
+		"--dir", devnetDir,
+		"--eth-genesis", elGenesis,
+		"--engine-urls", "http://127.0.0.1:8551,http://127.0.0.1:8552,http://127.0.0.1:8553",
+		"--validators", "3",
+	}
+
+	// A failing node stops all the other ones.
+	ns := &testNodes{failing: "node1", running: make(map[string]bool)}
+	err = runStart(context.Background(), t, ns, homeDir, args...)
+	require.ErrorIs(t, err, errNodeFailed)
+	require.Equal(t, 3, ns.startedCount())
+	require.Zero(t, ns.runningCount())
+
+	// Every node shares the generated genesis and peers with the other ones.
+	genesis, err := os.ReadFile(filepath.Join(devnetDir, "node0", "config", "genesis.json"))
+	require.NoError(t, err)
+	for _, moniker := range []string{"node0", "node1", "node2"} {
+		nodeGenesis, errRead := os.ReadFile(filepath.Join(devnetDir, moniker, "config", "genesis.json"))
+		require.NoError(t, errRead)
+		require.Equal(t, genesis, nodeGenesis, moniker)
+
+		nodeCfg := viper.New()
+		nodeCfg.SetConfigFile(filepath.Join(devnetDir, moniker, "config", "config.toml"))
+		require.NoError(t, nodeCfg.ReadInConfig())
+		require.Len(t, strings.Split(nodeCfg.GetString("p2p.persistent_peers"), ","), 2, moniker)
+		require.False(t, nodeCfg.GetBool("p2p.pex"), moniker)
+	}
+
+	// The existing devnet is restarted as is, until it is shut down.
+	ns = &testNodes{running: make(map[string]bool)}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- runStart(ctx, t, ns, homeDir, args...) }()
+	require.Eventually(t, func() bool { return ns.runningCount() == 3 }, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	require.Zero(t, ns.runningCount())
+	restartedGenesis, err := os.ReadFile(filepath.Join(devnetDir, "node0", "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, genesis, restartedGenesis)
+
+	// It cannot be restarted with another number of validators.
+	ns = &testNodes{running: make(map[string]bool)}
+	err = runStart(context.Background(), t, ns, homeDir, "--dir", devnetDir, "--validators", "2")
+	require.ErrorIs(t, err, devnet.ErrValidatorsMismatch)
+	require.Zero(t, ns.startedCount())
+}
@@ -25,29 +25,53 @@ import (
 
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
 	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+// ErrInvalidRollbackHeights is returned when the number of heights to roll
+// back is not positive or goes below the first block.
+var ErrInvalidRollbackHeights = errors.New("invalid number of heights to rollback")
+
+// ErrHeightMismatch is returned when the CometBFT state and the application
+// are not at the same height.
+var ErrHeightMismatch = errors.New("CometBFT and application heights differ")
+
+// NewRollbackCmd creates a command to rollback CometBFT, multistore and the
+// auxiliary stores state by a number of heights.
+//
+//nolint:funlen // long description.
 func NewRollbackCmd(
 	appCreator types.AppCreator,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		numHeights  int64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK, CometBFT and beacon-kit state by a number of heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
-progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting CometBFT the transactions in block n will be re-executed against the
-application.
+progress. Rollback overwrites a state at height n with the state at height n - heights.
+The application also rolls back to height n - heights. Blob sidecars stored for the
+slots above the rolled back state are removed and the finalized deposits are rolled back
+to the ones included by the rolled back state. Before anything is modified, the deposit
+store is checked to be consistent with the rolled back state and the CometBFT state is
+checked to be at the same height as the application. Only the block at
+height n - heights + 1 is kept (unless --hard is set), so upon restarting CometBFT its
+transactions will be re-executed against the application and the following blocks
+will be synced again. Beacon block indexes are rebuilt in memory and need no rollback.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
@@ -60,20 +84,107 @@ application.
 			}
 			app := appCreator(logger, db, nil, cfg, v)
 
-			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
+			latestHeight := app.CommitMultiStore().LastCommitID().Version
+			target := latestHeight - numHeights
+			if numHeights <= 0 || target < 1 {
+				return fmt.Errorf(
+					"%w: %d from height %d", ErrInvalidRollbackHeights, numHeights, latestHeight,
+				)
+			}
+
+			// Check the auxiliary stores against the target state before
+			// modifying anything, so that the rollback is never left halfway.
+			latestCtx := sdk.NewContext(
+				app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(logger),
+			).WithContext(cmd.Context())
+			latestSlot, err := app.StorageBackend().StateFromContext(latestCtx).GetSlot()
+			if err != nil {
+				return err
+			}
+			targetStore, err := app.CommitMultiStore().CacheMultiStoreWithVersion(target)
+			if err != nil {
+				return fmt.Errorf("failed to load state at height %d: %w", target, err)
+			}
+			targetCtx := sdk.NewContext(
+				targetStore, false, servercmtlog.WrapSDKLogger(logger),
+			).WithContext(cmd.Context())
+			targetState := app.StorageBackend().StateFromContext(targetCtx)
+			targetSlot, err := targetState.GetSlot()
+			if err != nil {
+				return err
+			}
+			eth1Data, err := targetState.GetEth1Data()
+			if err != nil {
+				return err
+			}
+			if err = core.ValidateNonGenesisDeposits(
+				targetCtx, targetState, app.StorageBackend().DepositStore(), 0, nil, eth1Data.DepositRoot,
+			); err != nil {
+				return fmt.Errorf("deposit store is inconsistent with state at height %d: %w", target, err)
+			}
+			cmtHeight, err := cometStateHeight(cfg)
+			if err != nil {
+				return err
+			}
+			if cmtHeight != latestHeight {
+				return fmt.Errorf(
+					"%w: CometBFT state at height %d, application at height %d; restart the node to reconcile them",
+					ErrHeightMismatch, cmtHeight, latestHeight,
+				)
+			}
+
+			// Roll the finalized deposits back to the ones included by the
+			// target state. This is done first since a deposit tree snapshot
+			// behind the state is moved forward again as blocks are finalized.
+			targetDepositIndex, err := targetState.GetEth1DepositIndex()
+			if err != nil {
+				return err
+			}
+			targetHeader, err := targetState.GetLatestExecutionPayloadHeader()
 			if err != nil {
+				return err
+			}
+			if err = app.StorageBackend().DepositStore().RollbackFinalized(
+				cmd.Context(), targetDepositIndex, targetHeader.GetBlockHash(), targetHeader.GetNumber(),
+			); err != nil {
+				return fmt.Errorf("failed to rollback finalized deposits: %w", err)
+			}
+
+			// rollback CometBFT state. CometBFT only tolerates its state to be
+			// one height behind its block store, so all the blocks but the one
+			// following the target height are removed along the way.
+			height, hash := cmtHeight, []byte(nil)
+			for height > target+1 {
+				if height, hash, err = cmtcmd.RollbackState(cfg, true); err != nil {
+					return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+				}
+			}
+			if height, hash, err = cmtcmd.RollbackState(cfg, removeBlock); err != nil {
 				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
 			}
+			if height != target {
+				return fmt.Errorf(
+					"CometBFT state rolled back to height %d instead of %d", height, target,
+				)
+			}
 
 			// rollback the multistore
 			if err = app.CommitMultiStore().RollbackToVersion(height); err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
+			// Remove the blob sidecars ahead of the rolled back state, including
+			// the ones persisted for a block the node stopped before committing.
+			if err = app.StorageBackend().AvailabilityStore().Truncate(
+				targetSlot.Unwrap()+1, latestSlot.Unwrap()+2, //nolint:mnd // see above.
+			); err != nil {
+				return fmt.Errorf("failed to remove blob sidecars above slot %d: %w", targetSlot, err)
+			}
+
 			logger.Info(
 				"Rolled back state",
 				"height", height,
+				"slot", targetSlot.Base10(),
 				"hash", fmt.Sprintf("%X", hash),
 			)
 			return nil
@@ -82,5 +193,22 @@ application.
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().
+		Int64Var(&numHeights, "heights", 1, "number of heights to rollback")
 	return cmd
 }
+
+// cometStateHeight returns the height of the CometBFT state stored under the
+// given config.
+func cometStateHeight(cfg *cmtcfg.Config) (int64, error) {
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return 0, err
+	}
+	defer stateDB.Close()
+	state, err := sm.NewStore(stateDB, sm.StoreOptions{}).Load()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load CometBFT state: %w", err)
+	}
+	return state.LastBlockHeight, nil
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/server"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	nodestorage "github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	sm "github.com/cometbft/cometbft/state"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode exposes the stores of a node to the rollback command.
+type testNode struct {
+	cms     store.CommitMultiStore
+	backend *nodestorage.Backend
+}
+
+func (n *testNode) CommitMultiStore() store.CommitMultiStore { return n.cms }
+
+func (n *testNode) StorageBackend() blockchain.StorageBackend { return n.backend }
+
+func (n *testNode) Start(context.Context) error { return nil }
+
+// testDeposits returns the deposits of the test chain. The first two are
+// included at genesis and the last one at the latest height.
+func testDeposits(cs chain.Spec) ctypes.Deposits {
+	return ctypes.Deposits{
+		{Pubkey: crypto.BLSPubkey{0x01}, Amount: cs.MaxEffectiveBalance(), Index: 0},
+		{Pubkey: crypto.BLSPubkey{0x02}, Amount: cs.MaxEffectiveBalance(), Index: 1},
+		{Pubkey: crypto.BLSPubkey{0x03}, Amount: cs.MaxEffectiveBalance(), Index: 2},
+	}
+}
+
+// startChain runs a CometBFT node with the kvstore application until it has
+// committed a few blocks, stops it and returns its config.
+func startChain(t *testing.T) *cmtcfg.Config {
+	t.Helper()
+	cfg := rpctest.GetConfig(true)
+	cfg.DBBackend = "pebbledb"
+	t.Cleanup(func() { require.NoError(t, os.RemoveAll(cfg.RootDir)) })
+
+	node := rpctest.StartCometBFT(kvstore.NewInMemoryApplication(), rpctest.SuppressStdout)
+	require.Eventually(t, func() bool {
+		return node.BlockStore().Height() >= 5
+	}, 30*time.Second, 10*time.Millisecond)
+	require.NoError(t, node.Stop())
+	node.Wait()
+	return cfg
+}
+
+// cometHeight returns the height of the CometBFT state of the given config.
+func cometHeight(t *testing.T, cfg *cmtcfg.Config) int64 {
+	t.Helper()
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, stateDB.Close()) }()
+	state, err := sm.NewStore(stateDB, sm.StoreOptions{}).Load()
+	require.NoError(t, err)
+	return state.LastBlockHeight
+}
+
+// writeTestStates commits a beacon state at each height up to the given one,
+// the state at height h being at slot h-1. The genesis deposits are included
+// at height 1 and the last deposit at the latest height.
+func writeTestStates(t *testing.T, cs chain.Spec, rootDir string, height int64) {
+	t.Helper()
+	appDB, err := db.OpenDB(rootDir, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, appDB.Close()) }()
+
+	cms := store.NewCommitMultiStore(appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	sdkCtx := sdk.NewContext(cms, false, log.NewNopLogger())
+	kvStore := beacondb.New(&storage.KVStoreService{Key: storage.StoreKey})
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	deposits := testDeposits(cs)
+	sp, _, _, _, _, _ := statetransition.SetupTestState(t, cs)
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, deposits[:2],
+		ctypes.NewEmptyExecutionPayloadHeaderWithVersion(cs.GenesisForkVersion()),
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+	cms.Commit()
+
+	for h := int64(2); h <= height; h++ {
+		require.NoError(t, st.SetSlot(math.Slot(h-1)))
+		if h == height {
+			require.NoError(t, st.SetEth1DepositIndex(3))
+			require.NoError(t, st.SetEth1Data(&ctypes.Eth1Data{
+				DepositRoot: deposits.HashTreeRoot(), DepositCount: 3,
+			}))
+		}
+		cms.Commit()
+	}
+}
+
+// persistSidecars persists a blob sidecar for each of the given slots.
+func persistSidecars(t *testing.T, s *dastore.Store, slots ...math.Slot) {
+	t.Helper()
+	for _, slot := range slots {
+		require.NoError(t, s.Persist(datypes.BlobSidecars{{
+			SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+				Header: &ctypes.BeaconBlockHeader{Slot: slot},
+			},
+			InclusionProof: make([]common.Root, ctypes.KZGInclusionProofDepth),
+		}}))
+	}
+}
+
+// rollbackFixture is a node whose application and CometBFT stores are at the
+// same height, along with its auxiliary stores.
+type rollbackFixture struct {
+	cs                chain.Spec
+	cmtCfg            *cmtcfg.Config
+	depositStore      deposit.StoreManager
+	availabilityStore *dastore.Store
+	appHeight         int64
+}
+
+func newRollbackFixture(t *testing.T, appHeightOffset int64) *rollbackFixture {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	cmtCfg := startChain(t)
+	appHeight := cometHeight(t, cmtCfg) + appHeightOffset
+	writeTestStates(t, cs, cmtCfg.RootDir, appHeight)
+
+	depositStore := deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	require.NoError(t, depositStore.EnqueueDeposits(context.Background(), testDeposits(cs)))
+	require.NoError(t, depositStore.Finalize(context.Background(), 3, common.ExecutionHash{0x01}, 3))
+
+	logger := log.NewNopLogger()
+	availabilityStore := dastore.New(
+		filedb.NewRangeDB(filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0700),
+			filedb.WithLogger(logger),
+		)),
+		logger,
+	)
+	for slot := range appHeight + 1 {
+		persistSidecars(t, availabilityStore, math.Slot(slot))
+	}
+	return &rollbackFixture{
+		cs:                cs,
+		cmtCfg:            cmtCfg,
+		depositStore:      depositStore,
+		availabilityStore: availabilityStore,
+		appHeight:         appHeight,
+	}
+}
+
+// runRollback runs the rollback command against the fixture and returns the
+// version of its multistore afterwards.
+func (f *rollbackFixture) runRollback(t *testing.T, args ...string) (int64, error) {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, f.cmtCfg.RootDir)
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(io.Discard, nil))
+
+	var cms store.CommitMultiStore
+	appCreator := func(
+		_ *phuslu.Logger, appDB dbm.DB, _ io.Writer, _ *cmtcfg.Config, _ servertypes.AppOptions,
+	) types.Node {
+		cms = store.NewCommitMultiStore(appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+		cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, cms.LoadLatestVersion())
+		backend := nodestorage.NewBackend(
+			f.cs, f.availabilityStore, beacondb.New(&storage.KVStoreService{Key: storage.StoreKey}),
+			f.depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+		)
+		return &testNode{cms: cms, backend: backend}
+	}
+
+	cmd := server.NewRollbackCmd(appCreator)
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	err := cmd.ExecuteContext(ctx)
+	return cms.LastCommitID().Version, err
+}
+
+func TestRollback(t *testing.T) {
+	f := newRollbackFixture(t, 0)
+	target := f.appHeight - 2
+
+	version, err := f.runRollback(t, "--heights", "2")
+	require.NoError(t, err)
+	require.Equal(t, target, version)
+	require.Equal(t, target, cometHeight(t, f.cmtCfg))
+
+	// The deposit included at the latest height is no longer finalized.
+	require.Equal(t, uint64(2), f.depositStore.Snapshot().DepositCount.Unwrap())
+
+	// The sidecars above the slot of the target state are removed.
+	for slot := range f.appHeight + 1 {
+		sidecars, errGet := f.availabilityStore.GetBlobSidecars(math.Slot(slot))
+		require.NoError(t, errGet)
+		if slot < target {
+			require.Len(t, sidecars, 1, slot)
+		} else {
+			require.Empty(t, sidecars, slot)
+		}
+	}
+}
+
+func TestRollbackHeightMismatch(t *testing.T) {
+	f := newRollbackFixture(t, -1)
+	cmtHeight := cometHeight(t, f.cmtCfg)
+
+	version, err := f.runRollback(t, "--heights", "2")
+	require.ErrorIs(t, err, server.ErrHeightMismatch)
+
+	// Nothing is rolled back.
+	require.Equal(t, f.appHeight, version)
+	require.Equal(t, cmtHeight, cometHeight(t, f.cmtCfg))
+	require.Equal(t, uint64(3), f.depositStore.Snapshot().DepositCount.Unwrap())
+	sidecars, err := f.availabilityStore.GetBlobSidecars(math.Slot(f.appHeight))
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+}
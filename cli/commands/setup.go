@@ -21,12 +21,17 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/cli/commands/dev"
+	"github.com/berachain/beacon-kit/cli/commands/devnet"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -48,8 +53,16 @@ func DefaultRootCommandSetup(
 		initialize.InitCmd(chainSpecCreator, mm),
 		// `genesis`
 		genesis.Commands(chainSpecCreator),
+		// `db`
+		db.Commands(chainSpecCreator, appCreator),
+		// `debug`
+		debug.Commands(chainSpecCreator, appCreator),
 		// `deposit`
 		deposit.Commands(chainSpecCreator, appCreator),
+		// `dev`
+		dev.Commands(chainSpecCreator),
+		// `devnet`
+		devnet.Commands(chainSpecCreator, appCreator, mm),
 		// `jwt`
 		jwt.Commands(),
 		// `rollback`
@@ -58,6 +71,8 @@ func DefaultRootCommandSetup(
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions{
 			AddFlags: flags.AddBeaconKitFlags,
 		}),
+		// `state`
+		state.Commands(chainSpecCreator),
 		// `status`
 		cmtcli.StatusCommand(),
 		// `version`
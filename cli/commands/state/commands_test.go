@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package state_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestStates commits a genesis state with two validators at height 1.
+// At height 2, the balance of the first validator is increased, the second
+// one is slashed and a third one is added.
+func writeTestStates(t *testing.T, cs chain.Spec, rootDir string) {
+	t.Helper()
+	appDB, err := db.OpenDB(rootDir, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, appDB.Close()) }()
+
+	cms := store.NewCommitMultiStore(appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	sdkCtx := sdk.NewContext(cms, false, log.NewNopLogger())
+	kvStore := beacondb.New(&storage.KVStoreService{Key: storage.StoreKey})
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	deposits := ctypes.Deposits{
+		{Pubkey: crypto.BLSPubkey{0x01}, Amount: cs.MaxEffectiveBalance(), Index: 0},
+		{Pubkey: crypto.BLSPubkey{0x02}, Amount: cs.MaxEffectiveBalance(), Index: 1},
+	}
+	sp, _, _, _, _, _ := statetransition.SetupTestState(t, cs)
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, deposits,
+		ctypes.NewEmptyExecutionPayloadHeaderWithVersion(cs.GenesisForkVersion()),
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+	cms.Commit()
+
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, st.IncreaseBalance(0, 1))
+	slashed, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	slashed.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(1, slashed))
+	require.NoError(t, st.AddValidator(&ctypes.Validator{
+		Pubkey:                     crypto.BLSPubkey{0x03},
+		ActivationEligibilityEpoch: constants.FarFutureEpoch,
+		ActivationEpoch:            constants.FarFutureEpoch,
+		ExitEpoch:                  constants.FarFutureEpoch,
+		WithdrawableEpoch:          constants.FarFutureEpoch,
+	}))
+	cms.Commit()
+}
+
+// dbFiles returns the size of the files of the application database of the
+// node at rootDir, keyed by name.
+func dbFiles(t *testing.T, rootDir string) map[string]int64 {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(rootDir, "data", "application.db"))
+	require.NoError(t, err)
+	files := make(map[string]int64, len(entries))
+	for _, entry := range entries {
+		info, errInfo := entry.Info()
+		require.NoError(t, errInfo)
+		files[entry.Name()] = info.Size()
+	}
+	return files
+}
+
+// runStateCmd runs the state command with the given arguments against the
+// node at rootDir and returns its output.
+func runStateCmd(t *testing.T, cs chain.Spec, rootDir string, args ...string) (string, error) {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, rootDir)
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(io.Discard, nil))
+
+	var out bytes.Buffer
+	cmd := state.Commands(func(servertypes.AppOptions) (chain.Spec, error) { return cs, nil })
+	cmd.SetArgs(args)
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	rootDir := t.TempDir()
+	writeTestStates(t, cs, rootDir)
+	files := dbFiles(t, rootDir)
+
+	out, err := runStateCmd(t, cs, rootDir, "diff", "1", "2")
+	require.NoError(t, err)
+
+	var d struct {
+		ValidatorsAdded         []json.RawMessage `json:"validators_added"`
+		ValidatorsExited        []json.RawMessage `json:"validators_exited"`
+		ValidatorsSlashed       []json.RawMessage `json:"validators_slashed"`
+		EffectiveBalanceChanges []json.RawMessage `json:"effective_balance_changes"`
+		BalanceChanges          []struct {
+			Delta int64 `json:"delta"`
+		} `json:"balance_changes"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &d))
+	require.Len(t, d.ValidatorsAdded, 1)
+	require.Empty(t, d.ValidatorsExited)
+	require.Len(t, d.ValidatorsSlashed, 1)
+	require.Empty(t, d.EffectiveBalanceChanges)
+	require.Len(t, d.BalanceChanges, 1)
+	require.Equal(t, int64(1), d.BalanceChanges[0].Delta)
+
+	out, err = runStateCmd(t, cs, rootDir, "diff", "1", "2", "-o", "table")
+	require.NoError(t, err)
+	require.Contains(t, out, "VALIDATORS SLASHED")
+
+	_, err = runStateCmd(t, cs, rootDir, "diff", "1", "3")
+	require.Error(t, err)
+
+	// The database is opened read-only, hence left untouched.
+	require.Equal(t, files, dbFiles(t, rootDir))
+}
+
+func TestSections(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	rootDir := t.TempDir()
+	writeTestStates(t, cs, rootDir)
+
+	// The dump holds every section, each of which can be printed on its own.
+	out, err := runStateCmd(t, cs, rootDir, "dump", "--height", "1")
+	require.NoError(t, err)
+	var dump map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(out), &dump))
+	require.Len(t, dump, 7)
+	for name, value := range dump {
+		out, err = runStateCmd(t, cs, rootDir, "get", name, "--height", "1")
+		require.NoError(t, err, name)
+		require.JSONEq(t, string(value), out, name)
+
+		out, err = runStateCmd(t, cs, rootDir, "get", name, "-o", "table")
+		require.NoError(t, err, name)
+		require.NotEmpty(t, out, name)
+	}
+
+	// The latest height is read by default.
+	out, err = runStateCmd(t, cs, rootDir, "get", "validators")
+	require.NoError(t, err)
+	var validators []json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(out), &validators))
+	require.Len(t, validators, 3)
+
+	_, err = runStateCmd(t, cs, rootDir, "get", "unknown")
+	require.ErrorIs(t, err, state.ErrUnknownSection)
+}
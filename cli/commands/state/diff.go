@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"strconv"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/cobra"
+)
+
+// stateDiff is the structural difference between the beacon states stored at
+// two heights.
+type stateDiff struct {
+	From                      *summary         `json:"from"`
+	To                        *summary         `json:"to"`
+	ValidatorsAdded           []*validator     `json:"validators_added"`
+	ValidatorsExited          []*validator     `json:"validators_exited"`
+	ValidatorsSlashed         []*validator     `json:"validators_slashed"`
+	EffectiveBalanceChanges   []*balanceChange `json:"effective_balance_changes"`
+	BalanceChanges            []*balanceChange `json:"balance_changes"`
+	PendingPartialWithdrawals [2]int           `json:"pending_partial_withdrawals"`
+}
+
+// balanceChange is the change of a validator balance between two heights.
+type balanceChange struct {
+	Index math.ValidatorIndex `json:"index"`
+	From  math.Gwei           `json:"from"`
+	To    math.Gwei           `json:"to"`
+	Delta int64               `json:"delta"`
+}
+
+// NewDiffCmd creates a new command printing the structural difference between
+// the beacon states stored at two heights.
+func NewDiffCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [from-height] [to-height]",
+		Short: "Prints the validators added, exited or slashed and the balance deltas between two heights",
+		Args:  cobra.ExactArgs(2), //nolint:mnd // from and to.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid from height %q", args[0])
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid to height %q", args[1])
+			}
+
+			r, err := newReader(cmd, chainSpecCreator)
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			from, err := r.snapshotAt(fromHeight)
+			if err != nil {
+				return err
+			}
+			to, err := r.snapshotAt(toHeight)
+			if err != nil {
+				return err
+			}
+
+			d := diff(from, to)
+			return printOutput(cmd, d, d.tables())
+		},
+	}
+
+	addFlags(cmd)
+	return cmd
+}
+
+// diff computes the structural difference between two snapshots.
+func diff(from, to *snapshot) *stateDiff {
+	d := &stateDiff{
+		From: summaryValue(from).(*summary), //nolint:errcheck // always a summary.
+		To:   summaryValue(to).(*summary),   //nolint:errcheck // always a summary.
+		PendingPartialWithdrawals: [2]int{
+			len(from.State.PendingPartialWithdrawals), len(to.State.PendingPartialWithdrawals),
+		},
+	}
+
+	for i, val := range to.State.Validators {
+		v := newValidator(to.State, i, val)
+		if i >= len(from.State.Validators) {
+			d.ValidatorsAdded = append(d.ValidatorsAdded, v)
+			continue
+		}
+
+		prev := from.State.Validators[i]
+		if prev.ExitEpoch == constants.FarFutureEpoch && val.ExitEpoch != constants.FarFutureEpoch {
+			d.ValidatorsExited = append(d.ValidatorsExited, v)
+		}
+		if !prev.Slashed && val.Slashed {
+			d.ValidatorsSlashed = append(d.ValidatorsSlashed, v)
+		}
+		if prev.EffectiveBalance != val.EffectiveBalance {
+			d.EffectiveBalanceChanges = append(
+				d.EffectiveBalanceChanges, newBalanceChange(i, prev.EffectiveBalance, val.EffectiveBalance),
+			)
+		}
+	}
+
+	for i, bal := range to.State.Balances {
+		var prev math.Gwei
+		if i < len(from.State.Balances) {
+			prev = math.Gwei(from.State.Balances[i])
+		}
+		if prev != math.Gwei(bal) {
+			d.BalanceChanges = append(d.BalanceChanges, newBalanceChange(i, prev, math.Gwei(bal)))
+		}
+	}
+	return d
+}
+
+func newBalanceChange(index int, from, to math.Gwei) *balanceChange {
+	return &balanceChange{
+		Index: math.ValidatorIndex(index),
+		From:  from,
+		To:    to,
+		Delta: int64(to) - int64(from), // #nosec G115 // balances fit in an int64.
+	}
+}
+
+// tables renders the diff as human readable tables.
+func (d *stateDiff) tables() []*table {
+	summary := keyValueTable(
+		"SUMMARY",
+		"height", strconv.FormatInt(d.From.Height, 10)+" -> "+strconv.FormatInt(d.To.Height, 10),
+		"slot", d.From.Slot.Base10()+" -> "+d.To.Slot.Base10(),
+		"eth1_deposit_index",
+		strconv.FormatUint(d.From.Eth1DepositIndex, 10)+" -> "+strconv.FormatUint(d.To.Eth1DepositIndex, 10),
+		"next_withdrawal_index",
+		strconv.FormatUint(d.From.NextWithdrawalIndex, 10)+" -> "+strconv.FormatUint(d.To.NextWithdrawalIndex, 10),
+		"pending_partial_withdrawals",
+		strconv.Itoa(d.PendingPartialWithdrawals[0])+" -> "+strconv.Itoa(d.PendingPartialWithdrawals[1]),
+	)
+
+	validators := func(title string, vals []*validator) *table {
+		t := &table{
+			title:  title,
+			header: []string{"INDEX", "PUBKEY", "EFFECTIVE_BALANCE", "BALANCE", "EXIT_EPOCH", "WITHDRAWABLE_EPOCH"},
+		}
+		for _, v := range vals {
+			t.rows = append(t.rows, []string{
+				v.Index.Base10(),
+				v.Validator.Pubkey.String(),
+				v.Validator.EffectiveBalance.Base10(),
+				v.Balance.Base10(),
+				formatEpoch(v.Validator.ExitEpoch),
+				formatEpoch(v.Validator.WithdrawableEpoch),
+			})
+		}
+		return t
+	}
+
+	balances := func(title string, changes []*balanceChange) *table {
+		t := &table{title: title, header: []string{"INDEX", "FROM", "TO", "DELTA"}}
+		for _, c := range changes {
+			t.rows = append(t.rows, []string{
+				c.Index.Base10(), c.From.Base10(), c.To.Base10(), strconv.FormatInt(c.Delta, 10),
+			})
+		}
+		return t
+	}
+
+	return []*table{
+		summary,
+		validators("VALIDATORS ADDED", d.ValidatorsAdded),
+		validators("VALIDATORS EXITED", d.ValidatorsExited),
+		validators("VALIDATORS SLASHED", d.ValidatorsSlashed),
+		balances("EFFECTIVE BALANCE CHANGES", d.EffectiveBalanceChanges),
+		balances("BALANCE CHANGES", d.BalanceChanges),
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"strings"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// ErrUnknownSection is returned when the requested part of the state does
+	// not exist.
+	ErrUnknownSection = errors.New("unknown state section")
+)
+
+// NewDumpCmd creates a new command printing the beacon state stored at a
+// given height.
+func NewDumpCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Prints the beacon state stored at a given height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			snap, err := loadSnapshot(cmd, chainSpecCreator)
+			if err != nil {
+				return err
+			}
+
+			values := make(map[string]any)
+			var tables []*table
+			for _, s := range sections() {
+				values[s.name] = s.value(snap)
+				tables = append(tables, s.table(snap))
+			}
+			return printOutput(cmd, values, tables)
+		},
+	}
+
+	addFlags(cmd)
+	cmd.Flags().Int64(flagHeight, 0, "Height of the state to print (defaults to the latest height)")
+	return cmd
+}
+
+// NewGetCmd creates a new command printing a single part of the beacon state
+// stored at a given height.
+func NewGetCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	names := make([]string, 0, len(sections()))
+	for _, s := range sections() {
+		names = append(names, s.name)
+	}
+
+	cmd := &cobra.Command{
+		Use:       "get [" + strings.Join(names, "|") + "]",
+		Short:     "Prints part of the beacon state stored at a given height",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: names,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, s := range sections() {
+				if s.name != args[0] {
+					continue
+				}
+				snap, err := loadSnapshot(cmd, chainSpecCreator)
+				if err != nil {
+					return err
+				}
+				return printOutput(cmd, s.value(snap), []*table{s.table(snap)})
+			}
+			return errors.Wrapf(ErrUnknownSection, "%q, expected one of %s", args[0], strings.Join(names, ", "))
+		},
+	}
+
+	addFlags(cmd)
+	cmd.Flags().Int64(flagHeight, 0, "Height of the state to print (defaults to the latest height)")
+	return cmd
+}
+
+// loadSnapshot reads the state stored at the height given by the command
+// flags.
+func loadSnapshot(cmd *cobra.Command, chainSpecCreator servertypes.ChainSpecCreator) (*snapshot, error) {
+	height, err := cmd.Flags().GetInt64(flagHeight)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newReader(cmd, chainSpecCreator)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return r.snapshotAt(height)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagHeight = "height"
+
+	// outputFormatTable prints the state as human readable tables.
+	outputFormatTable = "table"
+)
+
+var (
+	// ErrUnknownOutputFormat is returned when the output format is neither
+	// json nor table.
+	ErrUnknownOutputFormat = errors.New("unknown output format")
+)
+
+// table is the human readable rendering of part of the state.
+type table struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+// keyValueTable returns a table of two columns made of the given key and
+// value pairs.
+func keyValueTable(title string, pairs ...string) *table {
+	t := &table{title: title, header: []string{"FIELD", "VALUE"}}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		t.rows = append(t.rows, []string{pairs[i], pairs[i+1]})
+	}
+	return t
+}
+
+// write renders the table with aligned columns.
+func (t *table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd // padding.
+	fmt.Fprintf(tw, "%s\n", t.title)
+	fmt.Fprintf(tw, "%s\n", strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintf(tw, "%s\n", strings.Join(row, "\t"))
+	}
+	fmt.Fprintln(tw)
+	return tw.Flush()
+}
+
+// addFlags adds the flags shared by the state commands.
+func addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (json|table)")
+}
+
+// printOutput writes value as indented JSON, or tables as text, to the command
+// output depending on the requested output format.
+func printOutput(cmd *cobra.Command, value any, tables []*table) error {
+	format, err := cmd.Flags().GetString(flags.FlagOutput)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	switch format {
+	case flags.OutputFormatJSON:
+		var bz []byte
+		if bz, err = json.MarshalIndent(value, "", "  "); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(bz))
+		return err
+	case outputFormatTable:
+		for _, t := range tables {
+			if err = t.write(out); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Wrapf(ErrUnknownOutputFormat, "%q", format)
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// snapshot is the beacon state committed at a given height.
+type snapshot struct {
+	Height int64
+	State  *ctypes.BeaconState
+}
+
+// reader reads the beacon state committed by the node at any retained height.
+// Only the beacon store is mounted and the application database is opened
+// read-only, so the node's data is left untouched.
+type reader struct {
+	logger    *phuslu.Logger
+	chainSpec chain.Spec
+	db        dbm.DB
+	cms       storetypes.CommitMultiStore
+}
+
+// newReader opens the application database of the node configured on the
+// command.
+func newReader(cmd *cobra.Command, chainSpecCreator servertypes.ChainSpecCreator) (*reader, error) {
+	logger := clicontext.GetLoggerFromCmd(cmd)
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	chainSpec, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+	if err != nil {
+		return nil, err
+	}
+
+	appDB, err := db.OpenReadOnlyDB(cfg.RootDir)
+	if err != nil {
+		return nil, err
+	}
+	cms := store.NewCommitMultiStore(appDB, servercmtlog.WrapSDKLogger(logger), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+	// Fast nodes are not needed to read historical versions and loading the
+	// store would otherwise upgrade them in place.
+	cms.SetIAVLDisableFastNode(true)
+	if err = cms.LoadLatestVersion(); err != nil {
+		_ = appDB.Close()
+		return nil, errors.Wrap(err, "failed to load beacon store")
+	}
+
+	return &reader{
+		logger:    logger,
+		chainSpec: chainSpec,
+		db:        appDB,
+		cms:       cms,
+	}, nil
+}
+
+// Close releases the application database.
+func (r *reader) Close() error {
+	return r.db.Close()
+}
+
+// snapshotAt returns the beacon state committed at the given height, or at
+// the latest height if zero.
+func (r *reader) snapshotAt(height int64) (*snapshot, error) {
+	if height == 0 {
+		height = r.cms.LastCommitID().Version
+	}
+	cms, err := r.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load state at height %d", height)
+	}
+
+	ctx := sdk.NewContext(cms, false, servercmtlog.WrapSDKLogger(r.logger))
+	kvStore := beacondb.New(&storage.KVStoreService{Key: storage.StoreKey})
+	st, err := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(ctx), r.chainSpec, r.logger, metrics.NewNoOpTelemetrySink(),
+	).GetMarshallable()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read state at height %d", height)
+	}
+	return &snapshot{Height: height, State: st}, nil
+}
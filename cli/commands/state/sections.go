@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"strconv"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// section is a part of the beacon state that can be printed on its own.
+type section struct {
+	name  string
+	value func(*snapshot) any
+	table func(*snapshot) *table
+}
+
+// sections returns the parts of the beacon state printed by the state
+// commands, in the order they are dumped.
+func sections() []section {
+	return []section{
+		{name: "summary", value: summaryValue, table: summaryTable},
+		{name: "validators", value: validatorsValue, table: validatorsTable},
+		{name: "balances", value: balancesValue, table: balancesTable},
+		{
+			name:  "pending-partial-withdrawals",
+			value: func(s *snapshot) any { return s.State.PendingPartialWithdrawals },
+			table: pendingPartialWithdrawalsTable,
+		},
+		{
+			name:  "eth1-data",
+			value: func(s *snapshot) any { return s.State.Eth1Data },
+			table: eth1DataTable,
+		},
+		{
+			name:  "randao-mixes",
+			value: func(s *snapshot) any { return s.State.RandaoMixes },
+			table: randaoMixesTable,
+		},
+		{
+			name:  "payload-header",
+			value: func(s *snapshot) any { return s.State.LatestExecutionPayloadHeader },
+			table: payloadHeaderTable,
+		},
+	}
+}
+
+// summary holds the scalar fields of the beacon state.
+type summary struct {
+	Height                       int64                     `json:"height"`
+	Slot                         math.Slot                 `json:"slot"`
+	Fork                         *ctypes.Fork              `json:"fork"`
+	GenesisValidatorsRoot        common.Root               `json:"genesis_validators_root"`
+	LatestBlockHeader            *ctypes.BeaconBlockHeader `json:"latest_block_header"`
+	Eth1DepositIndex             uint64                    `json:"eth1_deposit_index"`
+	NextWithdrawalIndex          uint64                    `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex       `json:"next_withdrawal_validator_index"`
+	TotalSlashing                math.Gwei                 `json:"total_slashing"`
+}
+
+func summaryValue(s *snapshot) any {
+	return &summary{
+		Height:                       s.Height,
+		Slot:                         s.State.Slot,
+		Fork:                         s.State.Fork,
+		GenesisValidatorsRoot:        s.State.GenesisValidatorsRoot,
+		LatestBlockHeader:            s.State.LatestBlockHeader,
+		Eth1DepositIndex:             s.State.Eth1DepositIndex,
+		NextWithdrawalIndex:          s.State.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: s.State.NextWithdrawalValidatorIndex,
+		TotalSlashing:                s.State.TotalSlashing,
+	}
+}
+
+func summaryTable(s *snapshot) *table {
+	st := s.State
+	return keyValueTable(
+		"SUMMARY",
+		"height", strconv.FormatInt(s.Height, 10),
+		"slot", st.Slot.Base10(),
+		"fork_previous_version", st.Fork.PreviousVersion.String(),
+		"fork_current_version", st.Fork.CurrentVersion.String(),
+		"fork_epoch", st.Fork.Epoch.Base10(),
+		"genesis_validators_root", st.GenesisValidatorsRoot.String(),
+		"latest_block_header_slot", st.LatestBlockHeader.Slot.Base10(),
+		"latest_block_header_proposer_index", st.LatestBlockHeader.ProposerIndex.Base10(),
+		"latest_block_header_parent_root", st.LatestBlockHeader.ParentBlockRoot.String(),
+		"latest_block_header_state_root", st.LatestBlockHeader.StateRoot.String(),
+		"latest_block_header_body_root", st.LatestBlockHeader.BodyRoot.String(),
+		"eth1_deposit_index", strconv.FormatUint(st.Eth1DepositIndex, 10),
+		"next_withdrawal_index", strconv.FormatUint(st.NextWithdrawalIndex, 10),
+		"next_withdrawal_validator_index", st.NextWithdrawalValidatorIndex.Base10(),
+		"total_slashing", st.TotalSlashing.Base10(),
+	)
+}
+
+// validator is a validator of the registry along with its index and balance,
+// in the format served by the node API.
+type validator struct {
+	Index     math.ValidatorIndex `json:"index"`
+	Balance   math.Gwei           `json:"balance"`
+	Validator *ctypes.Validator   `json:"validator"`
+}
+
+func validatorsValue(s *snapshot) any {
+	validators := make([]*validator, len(s.State.Validators))
+	for i, val := range s.State.Validators {
+		validators[i] = newValidator(s.State, i, val)
+	}
+	return validators
+}
+
+func newValidator(st *ctypes.BeaconState, index int, val *ctypes.Validator) *validator {
+	v := &validator{Index: math.ValidatorIndex(index), Validator: val}
+	if index < len(st.Balances) {
+		v.Balance = math.Gwei(st.Balances[index])
+	}
+	return v
+}
+
+func validatorsTable(s *snapshot) *table {
+	t := &table{
+		title: "VALIDATORS",
+		header: []string{
+			"INDEX", "PUBKEY", "WITHDRAWAL_CREDENTIALS", "EFFECTIVE_BALANCE", "BALANCE", "SLASHED",
+			"ACTIVATION_ELIGIBILITY_EPOCH", "ACTIVATION_EPOCH", "EXIT_EPOCH", "WITHDRAWABLE_EPOCH",
+		},
+	}
+	for i, val := range s.State.Validators {
+		v := newValidator(s.State, i, val)
+		t.rows = append(t.rows, []string{
+			v.Index.Base10(),
+			val.Pubkey.String(),
+			val.WithdrawalCredentials.String(),
+			val.EffectiveBalance.Base10(),
+			v.Balance.Base10(),
+			strconv.FormatBool(val.Slashed),
+			formatEpoch(val.ActivationEligibilityEpoch),
+			formatEpoch(val.ActivationEpoch),
+			formatEpoch(val.ExitEpoch),
+			formatEpoch(val.WithdrawableEpoch),
+		})
+	}
+	return t
+}
+
+// balance is the balance of the validator at the given index.
+type balance struct {
+	Index   math.ValidatorIndex `json:"index"`
+	Balance math.Gwei           `json:"balance"`
+}
+
+func balancesValue(s *snapshot) any {
+	balances := make([]*balance, len(s.State.Balances))
+	for i, bal := range s.State.Balances {
+		balances[i] = &balance{Index: math.ValidatorIndex(i), Balance: math.Gwei(bal)}
+	}
+	return balances
+}
+
+func balancesTable(s *snapshot) *table {
+	t := &table{title: "BALANCES", header: []string{"INDEX", "BALANCE"}}
+	for i, bal := range s.State.Balances {
+		t.rows = append(t.rows, []string{strconv.Itoa(i), strconv.FormatUint(bal, 10)})
+	}
+	return t
+}
+
+func pendingPartialWithdrawalsTable(s *snapshot) *table {
+	t := &table{
+		title:  "PENDING PARTIAL WITHDRAWALS",
+		header: []string{"VALIDATOR_INDEX", "AMOUNT", "WITHDRAWABLE_EPOCH"},
+	}
+	for _, w := range s.State.PendingPartialWithdrawals {
+		t.rows = append(t.rows, []string{
+			w.ValidatorIndex.Base10(), w.Amount.Base10(), formatEpoch(w.WithdrawableEpoch),
+		})
+	}
+	return t
+}
+
+func eth1DataTable(s *snapshot) *table {
+	return keyValueTable(
+		"ETH1 DATA",
+		"deposit_root", s.State.Eth1Data.DepositRoot.String(),
+		"deposit_count", s.State.Eth1Data.DepositCount.Base10(),
+		"block_hash", s.State.Eth1Data.BlockHash.String(),
+		"eth1_deposit_index", strconv.FormatUint(s.State.Eth1DepositIndex, 10),
+	)
+}
+
+func randaoMixesTable(s *snapshot) *table {
+	t := &table{title: "RANDAO MIXES", header: []string{"INDEX", "MIX"}}
+	for i, mix := range s.State.RandaoMixes {
+		t.rows = append(t.rows, []string{strconv.Itoa(i), mix.String()})
+	}
+	return t
+}
+
+func payloadHeaderTable(s *snapshot) *table {
+	h := s.State.LatestExecutionPayloadHeader
+	return keyValueTable(
+		"LATEST EXECUTION PAYLOAD HEADER",
+		"block_number", h.Number.Base10(),
+		"block_hash", h.BlockHash.String(),
+		"parent_hash", h.ParentHash.String(),
+		"timestamp", h.Timestamp.Base10(),
+		"fee_recipient", h.FeeRecipient.String(),
+		"state_root", h.StateRoot.String(),
+		"receipts_root", h.ReceiptsRoot.String(),
+		"prev_randao", h.Random.String(),
+		"gas_limit", h.GasLimit.Base10(),
+		"gas_used", h.GasUsed.Base10(),
+		"base_fee_per_gas", h.BaseFeePerGas.Dec(),
+		"transactions_root", h.TransactionsRoot.String(),
+		"withdrawals_root", h.WithdrawalsRoot.String(),
+		"blob_gas_used", h.BlobGasUsed.Base10(),
+		"excess_blob_gas", h.ExcessBlobGas.Base10(),
+	)
+}
+
+// formatEpoch renders an epoch, leaving the far future epoch blank.
+func formatEpoch(epoch math.Epoch) string {
+	if epoch == constants.FarFutureEpoch {
+		return "-"
+	}
+	return epoch.Base10()
+}
@@ -35,6 +35,7 @@ const (
 	// Builder Config.
 	builderRoot           = beaconKitRoot + "payload-builder."
 	SuggestedFeeRecipient = builderRoot + "suggested-fee-recipient"
+	FeeRecipientsFile     = builderRoot + "fee-recipients-file"
 	BuilderEnabled        = builderRoot + "enabled"
 	BuildPayloadTimeout   = builderRoot + "payload-timeout"
 
@@ -52,6 +53,7 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	RPCRecordFile           = engineRoot + "rpc-record-file"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -59,10 +61,16 @@ const (
 	KZGImplementation   = kzgRoot + "implementation"
 
 	// Logger Config.
-	loggerRoot = beaconKitRoot + "logger."
-	TimeFormat = loggerRoot + "time-format"
-	LogLevel   = loggerRoot + "log-level"
-	Style      = loggerRoot + "style"
+	loggerRoot   = beaconKitRoot + "logger."
+	TimeFormat   = loggerRoot + "time-format"
+	LogLevel     = loggerRoot + "log-level"
+	Style        = loggerRoot + "style"
+	ModuleLevels = loggerRoot + "module-levels"
+
+	// Log Export Config.
+	logExportRoot     = beaconKitRoot + "log-export."
+	LogExportEnabled  = logExportRoot + "enabled"
+	LogExportEndpoint = logExportRoot + "endpoint"
 
 	// Block Store Service Config.
 	blockStoreServiceRoot               = beaconKitRoot + "block-store-service."
@@ -75,6 +83,27 @@ const (
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+	NodeAPIPprof   = nodeAPIRoot + "pprof"
+	NodeAPIAdmin   = nodeAPIRoot + "admin"
+
+	// Tracing Config.
+	tracingRoot     = beaconKitRoot + "tracing."
+	TracingEnabled  = tracingRoot + "enabled"
+	TracingEndpoint = tracingRoot + "endpoint"
+
+	// Profiling Config.
+	profilingRoot               = beaconKitRoot + "profiling."
+	ProfilingEnabled            = profilingRoot + "enabled"
+	ProfilingSlowBlockThreshold = profilingRoot + "slow-block-threshold"
+
+	// Metrics Config.
+	metricsRoot    = beaconKitRoot + "metrics."
+	MetricsEnabled = metricsRoot + "enabled"
+	MetricsAddress = metricsRoot + "address"
+
+	// Validator Monitor Config.
+	validatorMonitorRoot    = beaconKitRoot + "validator-monitor."
+	ValidatorMonitorPubkeys = validatorMonitorRoot + "pubkeys"
 
 	// BLS Config.
 	PrivValidatorKeyFile   = "priv_validator_key_file"
@@ -116,6 +145,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		RPCRecordFile,
+		defaultCfg.Engine.RPCRecordFile,
+		"file to record engine API traffic to (disabled if empty)",
+	)
 	startCmd.Flags().Bool(
 		BuilderEnabled,
 		defaultCfg.PayloadBuilder.Enabled,
@@ -131,6 +165,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().String(
+		FeeRecipientsFile,
+		defaultCfg.PayloadBuilder.FeeRecipientsFile,
+		"path to a file mapping validators to fee recipients",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -156,6 +195,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Logger.Style,
 		"style",
 	)
+	startCmd.Flags().String(
+		ModuleLevels,
+		defaultCfg.Logger.ModuleLevels,
+		"per-module log levels, e.g. blockchain=debug,comet=warn",
+	)
+	startCmd.Flags().Bool(
+		LogExportEnabled,
+		defaultCfg.LogExport.Enabled,
+		"export logs to an OTLP collector",
+	)
+	startCmd.Flags().String(
+		LogExportEndpoint,
+		defaultCfg.LogExport.Endpoint,
+		"OTLP/HTTP collector endpoint for logs",
+	)
 	startCmd.Flags().Bool(
 		BlockStoreServiceEnabled,
 		defaultCfg.BlockStoreService.Enabled,
@@ -181,4 +235,49 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"export traces to an OTLP collector",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"OTLP/HTTP collector endpoint",
+	)
+	startCmd.Flags().Bool(
+		NodeAPIPprof,
+		defaultCfg.NodeAPI.Pprof,
+		"serve the authenticated pprof endpoints",
+	)
+	startCmd.Flags().Bool(
+		NodeAPIAdmin,
+		defaultCfg.NodeAPI.Admin,
+		"serve the authenticated admin endpoints",
+	)
+	startCmd.Flags().Bool(
+		ProfilingEnabled,
+		defaultCfg.Profiling.Enabled,
+		"capture profiles of slow blocks",
+	)
+	startCmd.Flags().Duration(
+		ProfilingSlowBlockThreshold,
+		defaultCfg.Profiling.SlowBlockThreshold,
+		"duration past which profiles of a block are captured",
+	)
+	startCmd.Flags().Bool(
+		MetricsEnabled,
+		defaultCfg.Metrics.Enabled,
+		"serve prometheus metrics",
+	)
+	startCmd.Flags().String(
+		MetricsAddress,
+		defaultCfg.Metrics.Address,
+		"prometheus metrics address",
+	)
+	startCmd.Flags().StringSlice(
+		ValidatorMonitorPubkeys,
+		defaultCfg.ValidatorMonitor.Pubkeys,
+		"public keys of the validators to monitor",
+	)
 }
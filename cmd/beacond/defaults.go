@@ -39,9 +39,12 @@ func DefaultComponents() []any {
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
 		components.ProvideEngineClient,
+		components.ProvideELSyncMonitor,
 		components.ProvideExecutionEngine,
+		components.ProvideFeeRecipients,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvidePayloadIDCache,
 		components.ProvideReportingService,
 		components.ProvideCometBFTService,
 		components.ProvideServiceRegistry,
@@ -50,8 +53,13 @@ func DefaultComponents() []any {
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
 		components.ProvideTelemetrySink,
-		components.ProvideTelemetryService,
+		components.ProvideMetricsRegistry,
+		components.ProvideMetricsService,
+		components.ProvideTracingService,
+		components.ProvideProfiler,
+		components.ProvideLogExporter,
 		components.ProvideTrustedSetup,
+		components.ProvideValidatorMonitor,
 		components.ProvideValidatorService,
 		components.ProvideShutDownService,
 	}
@@ -63,13 +71,16 @@ func DefaultComponents() []any {
 
 	c = append(c,
 		components.ProvideNodeAPIHandlers,
+		components.ProvideNodeAPIAdminHandler,
 		components.ProvideNodeAPIBeaconHandler,
 		components.ProvideNodeAPIBuilderHandler,
 		components.ProvideNodeAPIConfigHandler,
 		components.ProvideNodeAPIDebugHandler,
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
+		components.ProvideNodeAPIProfilingHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 
 	return c
@@ -32,6 +32,11 @@ import (
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
+	"github.com/berachain/beacon-kit/observability/logexport"
+	"github.com/berachain/beacon-kit/observability/metrics"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -56,11 +61,16 @@ func DefaultConfig() *Config {
 		ShutdownTimeout:   defaultShutdownTimeout,
 		Engine:            engineclient.DefaultConfig(),
 		Logger:            log.DefaultConfig(),
+		LogExport:         logexport.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Tracing:           tracing.DefaultConfig(),
+		Metrics:           metrics.DefaultConfig(),
+		Profiling:         profiling.DefaultConfig(),
+		ValidatorMonitor:  validatormonitor.DefaultConfig(),
 	}
 }
 
@@ -77,6 +87,8 @@ type Config struct {
 	Engine engineclient.Config `mapstructure:"engine"`
 	// Logger is the configuration for the logger.
 	Logger log.Config `mapstructure:"logger"`
+	// LogExport is the configuration for exporting OTLP logs.
+	LogExport logexport.Config `mapstructure:"log-export"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
@@ -87,6 +99,14 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for exporting OTLP traces.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// Metrics is the configuration for serving Prometheus metrics.
+	Metrics metrics.Config `mapstructure:"metrics"`
+	// Profiling is the configuration for capturing profiles of slow blocks.
+	Profiling profiling.Config `mapstructure:"profiling"`
+	// ValidatorMonitor is the configuration for the validator monitor.
+	ValidatorMonitor validatormonitor.Config `mapstructure:"validator-monitor"`
 }
 
 // GetEngine returns the execution client configuration.
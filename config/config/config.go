@@ -24,7 +24,6 @@ import (
 	"fmt"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/spf13/viper"
 )
 
@@ -46,7 +45,9 @@ type BaseConfig struct {
 	// a node will gracefully halt and shutdown that can be used to assist
 	// upgrades and testing.
 	//
-	// Note: Commitment of state will be attempted on the corresponding block.
+	// Note: Blocks that may carry a payload timestamped at or after the halt
+	// time are not committed, so that setting it to a fork timestamp halts the
+	// node before the fork activates.
 	HaltTime uint64 `mapstructure:"halt-time"`
 
 	// MinRetainBlocks defines the minimum block height offset from the current
@@ -80,9 +81,6 @@ type BaseConfig struct {
 // Config defines the server's top level configuration.
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
-
-	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
 
 // DefaultConfig returns server's default configuration.
@@ -98,10 +96,6 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       5000,
 			IAVLDisableFastNode: false,
 		},
-		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
-		},
 	}
 }
 
@@ -25,7 +25,9 @@ halt-height = {{ .BaseConfig.HaltHeight }}
 # a node will gracefully halt and shutdown that can be used to assist upgrades
 # and testing.
 #
-# Note: Commitment of state will be attempted on the corresponding block.
+# Note: Blocks that may carry a payload timestamped at or after the halt time
+# are not committed, so that setting it to a fork timestamp halts the node
+# before the fork activates.
 halt-time = {{ .BaseConfig.HaltTime }}
 
 # MinRetainBlocks defines the minimum block height offset from the current
@@ -52,51 +54,4 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
 # IAVLDisableFastNode enables or disables the fast node feature of IAVL. 
 # Default is false.
-iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
-
-
-###############################################################################
-###                         Telemetry Configuration                         ###
-###############################################################################
-
-[telemetry]
-
-# Prefixed with keys to separate services.
-service-name = "{{ .Telemetry.ServiceName }}"
-
-# Enabled enables the application telemetry functionality. When enabled,
-# an in-memory sink is also enabled by default. Operators may also enabled
-# other sinks such as Prometheus.
-enabled = {{ .Telemetry.Enabled }}
-
-# Enable prefixing gauge values with hostname.
-enable-hostname = {{ .Telemetry.EnableHostname }}
-
-# Enable adding hostname to labels.
-enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
-
-# Enable adding service to labels.
-enable-service-label = {{ .Telemetry.EnableServiceLabel }}
-
-# PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
-prometheus-retention-time = {{ .Telemetry.PrometheusRetentionTime }}
-
-# GlobalLabels defines a global set of name/value label tuples applied to all
-# metrics emitted using the wrapper functions defined in telemetry package.
-#
-# Example:
-# [["chain_id", "cosmoshub-1"]]
-global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
-  ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
-]
-
-# MetricsSink defines the type of metrics sink to use.
-metrics-sink = "{{ .Telemetry.MetricsSink }}"
-
-# StatsdAddr defines the address of a statsd server to send metrics to.
-# Only utilized if MetricsSink is set to "statsd" or "dogstatsd".
-statsd-addr = "{{ .Telemetry.StatsdAddr }}"
-
-# DatadogHostname defines the hostname to use when emitting metrics to
-# Datadog. Only utilized if MetricsSink is set to "dogstatsd".
-datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
\ No newline at end of file
+iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
\ No newline at end of file
@@ -23,6 +23,7 @@ package spec
 import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -68,18 +69,33 @@ func DevnetChainSpecData() *chain.SpecData {
 	specData := MainnetChainSpecData()
 	specData.DepositEth1ChainID = DevnetEth1ChainID
 
-	// Fork timings are set to facilitate local testing across fork versions.
-	specData.GenesisTime = devnetGenesisTime
-	specData.Deneb1ForkTime = devnetDeneb1ForkTime
-	specData.ElectraForkTime = devnetElectraForkTime
-
 	// EVM inflation is different from mainnet to test.
-	specData.EVMInflationAddressGenesis = common.NewExecutionAddressFromHex(devnetEVMInflationAddress)
-	specData.EVMInflationPerBlockGenesis = devnetEVMInflationPerBlock
-
-	// EVM inflation is different from mainnet for now, after the Deneb1 fork.
-	specData.EVMInflationAddressDeneb1 = common.NewExecutionAddressFromHex(devnetEVMInflationAddressDeneb1)
-	specData.EVMInflationPerBlockDeneb1 = devnetEVMInflationPerBlockDeneb1
+	specData.EVMInflationAddress = common.NewExecutionAddressFromHex(devnetEVMInflationAddress)
+	specData.EVMInflationPerBlock = devnetEVMInflationPerBlock
+
+	// Fork timings are set to facilitate local testing across fork versions. EVM inflation is
+	// different from mainnet for now, after the Deneb1 fork.
+	specData.Forks = []chain.ScheduledFork{
+		{
+			Name:      version.Name(version.Deneb()),
+			Version:   version.Deneb(),
+			Timestamp: devnetGenesisTime,
+		},
+		{
+			Name:      version.Name(version.Deneb1()),
+			Version:   version.Deneb1(),
+			Timestamp: devnetDeneb1ForkTime,
+			Overrides: map[string]any{
+				"evm-inflation-address":   common.NewExecutionAddressFromHex(devnetEVMInflationAddressDeneb1),
+				"evm-inflation-per-block": uint64(devnetEVMInflationPerBlockDeneb1),
+			},
+		},
+		{
+			Name:      version.Name(version.Electra()),
+			Version:   version.Electra(),
+			Timestamp: devnetElectraForkTime,
+		},
+	}
 
 	// Staking is different from mainnet for now.
 	specData.MaxEffectiveBalance = devnetMaxStakeAmount
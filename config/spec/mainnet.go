@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -130,9 +131,27 @@ func MainnetChainSpecData() *chain.SpecData {
 		TargetSecondsPerEth1Block: defaultTargetSecondsPerEth1Block,
 
 		// Fork-related values.
-		GenesisTime:     mainnetGenesisTime,
-		Deneb1ForkTime:  mainnetDeneb1ForkTime,
-		ElectraForkTime: mainnetElectraForkTime,
+		Forks: []chain.ScheduledFork{
+			{
+				Name:      version.Name(version.Deneb()),
+				Version:   version.Deneb(),
+				Timestamp: mainnetGenesisTime,
+			},
+			{
+				Name:      version.Name(version.Deneb1()),
+				Version:   version.Deneb1(),
+				Timestamp: mainnetDeneb1ForkTime,
+				Overrides: map[string]any{
+					"evm-inflation-address":   common.NewExecutionAddressFromHex(mainnetEVMInflationAddressDeneb1),
+					"evm-inflation-per-block": uint64(mainnetEVMInflationPerBlockDeneb1),
+				},
+			},
+			{
+				Name:      version.Name(version.Electra()),
+				Version:   version.Electra(),
+				Timestamp: mainnetElectraForkTime,
+			},
+		},
 
 		// State list length constants.
 		EpochsPerHistoricalVector: defaultEpochsPerHistoricalVector,
@@ -152,13 +171,9 @@ func MainnetChainSpecData() *chain.SpecData {
 		BytesPerBlob:                     defaultBytesPerBlob,
 
 		// Berachain values at genesis.
-		ValidatorSetCap:             mainnetValidatorSetCap,
-		EVMInflationAddressGenesis:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
-		EVMInflationPerBlockGenesis: mainnetEVMInflationPerBlock,
-
-		// Deneb1 values.
-		EVMInflationAddressDeneb1:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddressDeneb1),
-		EVMInflationPerBlockDeneb1: mainnetEVMInflationPerBlockDeneb1,
+		ValidatorSetCap:      mainnetValidatorSetCap,
+		EVMInflationAddress:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
+		EVMInflationPerBlock: mainnetEVMInflationPerBlock,
 
 		// Electra values.
 		MinActivationBalance:             mainnetMinActivationBalance,
@@ -29,16 +29,18 @@ func TestnetChainSpecData() *chain.SpecData {
 	// Testnet uses chain ID of 80069.
 	specData.DepositEth1ChainID = TestnetEth1ChainID
 
+	// Bepolia follows the fork schedule of mainnet, at different times.
+	//
 	// Timestamp of the genesis block of Bepolia testnet.
-	specData.GenesisTime = 1739976735
+	specData.Forks[0].Timestamp = 1739976735
 
 	// Deneb1 fork timing on Bepolia. This is calculated based on the timestamp of the first bepolia
 	// epoch, block 192, which was used to initiate the fork when beacon-kit forked by epoch instead
 	// of by timestamp.
-	specData.Deneb1ForkTime = 1740090694
+	specData.Forks[1].Timestamp = 1740090694
 
 	// Timestamp of the Electra fork on Bepolia.
-	specData.ElectraForkTime = 1746633600
+	specData.Forks[2].Timestamp = 1746633600
 
 	return specData
 }
@@ -52,6 +52,10 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# File to record Engine API requests and responses to, for debugging.
+# Recording is disabled if empty.
+rpc-record-file = "{{.BeaconKit.Engine.RPCRecordFile}}"
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -63,6 +67,42 @@ log-level = "{{.BeaconKit.Logger.LogLevel}}"
 # Style is the style of the logger.
 style = "{{.BeaconKit.Logger.Style}}"
 
+# ModuleLevels overrides LogLevel for modules, as a comma separated list of
+# module=level pairs, e.g. "blockchain=debug,engine=info,comet=warn". A module
+# inherits the level of its parent module, e.g. "comet.p2p" the one of "comet".
+module-levels = "{{.BeaconKit.Logger.ModuleLevels}}"
+
+# SamplingBurst is the number of debug and info entries with the same message
+# logged in each SamplingInterval before sampling starts. 0 disables sampling.
+sampling-burst = "{{.BeaconKit.Logger.SamplingBurst}}"
+
+# SamplingThereafter is the sampling rate past SamplingBurst: only every
+# SamplingThereafter-th entry with the same message is logged.
+sampling-thereafter = "{{.BeaconKit.Logger.SamplingThereafter}}"
+
+# SamplingInterval is the interval sampling counters are reset at.
+sampling-interval = "{{.BeaconKit.Logger.SamplingInterval}}"
+
+[beacon-kit.log-export]
+# Enabled determines if logs are exported to an OTLP collector.
+enabled = "{{ .BeaconKit.LogExport.Enabled }}"
+
+# Endpoint is the host:port of the OTLP/HTTP collector.
+endpoint = "{{ .BeaconKit.LogExport.Endpoint }}"
+
+# Insecure disables TLS towards the collector.
+insecure = "{{ .BeaconKit.LogExport.Insecure }}"
+
+# ServiceName is the service name logs are reported under.
+service-name = "{{ .BeaconKit.LogExport.ServiceName }}"
+
+# FlushInterval is the interval queued logs are exported at.
+flush-interval = "{{ .BeaconKit.LogExport.FlushInterval }}"
+
+# QueueSize is the maximum number of logs queued for export. Logs are dropped
+# while the queue is full.
+queue-size = "{{ .BeaconKit.LogExport.QueueSize }}"
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -81,6 +121,13 @@ enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 # from this node.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
+# Path to a JSON file mapping validators, by public key or index, to the address
+# receiving the transaction fees of the blocks they propose, e.g.
+# {"0x<pubkey>": "0x<address>", "12": "0x<address>"}. Fee recipients registered
+# through /eth/v1/validator/prepare_beacon_proposer, which requires the node API
+# auth token, take precedence over it.
+fee-recipients-file = "{{ .BeaconKit.PayloadBuilder.FeeRecipientsFile }}"
+
 # The timeout for local build payload. This should match, or be slightly less
 # than the configured timeout on your execution client. It also must be less than
 # timeout_proposal in the CometBFT configuration.
@@ -110,4 +157,66 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# CacheSize is the maximum number of cached node API responses. 0 disables caching.
+cache-size = "{{ .BeaconKit.NodeAPI.CacheSize }}"
+
+# CacheMaxBytes is the approximate maximum size in bytes of the cached node API
+# responses. Responses larger than it, such as large validator lists, are not cached.
+cache-max-bytes = "{{ .BeaconKit.NodeAPI.CacheMaxBytes }}"
+
+# AuthToken is the bearer token required by the authenticated endpoints of the
+# node API. The authenticated endpoints reject every request if it is empty.
+auth-token = "{{ .BeaconKit.NodeAPI.AuthToken }}"
+
+# Pprof determines if the authenticated pprof endpoints are served under
+# /debug/pprof/.
+pprof = "{{ .BeaconKit.NodeAPI.Pprof }}"
+
+# Admin determines if the authenticated admin endpoints are served under
+# /admin/v1/, allowing to operate the node at runtime.
+admin = "{{ .BeaconKit.NodeAPI.Admin }}"
+
+[beacon-kit.tracing]
+# Enabled determines if traces are exported to an OTLP collector.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Endpoint is the host:port of the OTLP/HTTP collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS towards the collector.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# ServiceName is the service name traces are reported under.
+service-name = "{{ .BeaconKit.Tracing.ServiceName }}"
+
+# SampleRatio is the fraction of traces that are sampled, between 0 and 1.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+[beacon-kit.metrics]
+# Enabled determines if Prometheus metrics are served.
+enabled = "{{ .BeaconKit.Metrics.Enabled }}"
+
+# Address is the address to serve Prometheus metrics on, at /metrics.
+address = "{{ .BeaconKit.Metrics.Address }}"
+
+[beacon-kit.profiling]
+# Enabled determines if profiles of slow blocks are captured.
+enabled = "{{ .BeaconKit.Profiling.Enabled }}"
+
+# SlowBlockThreshold is the duration of FinalizeBlock or ProcessProposal past
+# which goroutine, CPU and heap profiles are captured.
+slow-block-threshold = "{{ .BeaconKit.Profiling.SlowBlockThreshold }}"
+
+# Dir is the directory profiles are written to. Defaults to data/profiles in
+# the home directory if empty.
+dir = "{{ .BeaconKit.Profiling.Dir }}"
+
+# MaxFiles is the maximum number of profiles kept in Dir. The oldest profiles
+# are removed past it.
+max-files = "{{ .BeaconKit.Profiling.MaxFiles }}"
+
+[beacon-kit.validator-monitor]
+# Pubkeys are the hex encoded public keys of the validators to monitor.
+pubkeys = [{{ range $i, $pubkey := .BeaconKit.ValidatorMonitor.Pubkeys }}{{ if $i }}, {{ end }}"{{ $pubkey }}"{{ end }}]
 `
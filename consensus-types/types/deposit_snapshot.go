@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// DepositTreeSnapshotFixedSize is the size of the fixed part of the
+// DepositTreeSnapshot object in bytes: 4 bytes for the Finalized offset +
+// 32 bytes for DepositRoot + 8 bytes for DepositCount + 32 bytes for
+// ExecutionBlockHash + 8 bytes for ExecutionBlockHeight.
+const DepositTreeSnapshotFixedSize = 84
+
+var (
+	_ ssz.DynamicObject                   = (*DepositTreeSnapshot)(nil)
+	_ constraints.SSZMarshallableRootable = (*DepositTreeSnapshot)(nil)
+)
+
+// DepositTreeSnapshot is the EIP-4881 snapshot of the deposit Merkle tree.
+// It holds the minimal set of hashes required to rebuild the finalized part
+// of the tree, so that the full deposit history is not needed to extend it.
+//
+// https://eips.ethereum.org/EIPS/eip-4881
+type DepositTreeSnapshot struct {
+	// Finalized are the roots of the largest full subtrees covering the
+	// finalized deposits, ordered from left to right.
+	Finalized []common.Root `json:"finalized"`
+	// DepositRoot is the root of the deposit tree at DepositCount.
+	DepositRoot common.Root `json:"depositRoot"`
+	// DepositCount is the number of finalized deposits.
+	DepositCount math.U64 `json:"depositCount"`
+	// ExecutionBlockHash is the hash of the execution block at which the
+	// deposits were finalized.
+	ExecutionBlockHash common.ExecutionHash `json:"executionBlockHash"`
+	// ExecutionBlockHeight is the height of the execution block at which
+	// the deposits were finalized.
+	ExecutionBlockHeight math.U64 `json:"executionBlockHeight"`
+}
+
+func NewEmptyDepositTreeSnapshot() *DepositTreeSnapshot {
+	return &DepositTreeSnapshot{}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the DepositTreeSnapshot object in SSZ encoding.
+func (s *DepositTreeSnapshot) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := uint32(DepositTreeSnapshotFixedSize)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticBytes(siz, s.Finalized)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the DepositTreeSnapshot object.
+func (s *DepositTreeSnapshot) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticBytesOffset(codec, &s.Finalized, constants.DepositContractDepth)
+	ssz.DefineStaticBytes(codec, &s.DepositRoot)
+	ssz.DefineUint64(codec, &s.DepositCount)
+	ssz.DefineStaticBytes(codec, &s.ExecutionBlockHash)
+	ssz.DefineUint64(codec, &s.ExecutionBlockHeight)
+
+	ssz.DefineSliceOfStaticBytesContent(codec, &s.Finalized, constants.DepositContractDepth)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DepositTreeSnapshot object.
+func (s *DepositTreeSnapshot) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+// MarshalSSZ marshals the DepositTreeSnapshot object to SSZ format.
+func (s *DepositTreeSnapshot) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+func (*DepositTreeSnapshot) ValidateAfterDecodingSSZ() error { return nil }
@@ -318,6 +318,8 @@ type GetPayloadRequest struct {
 	// ForkVersion is the fork version that we are
 	// currently on.
 	ForkVersion common.Version
+	// CellProofs is whether the blobs bundle must hold EIP-7594 cell proofs.
+	CellProofs bool
 }
 
 // BuildGetPayloadRequest builds a get payload request.
@@ -26,9 +26,12 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -63,7 +66,10 @@ func (s *Service) PrepareProposal(
 		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.prepareProposal(s.ctx, req)
+	ctx, span := tracing.Start(s.ctx, "PrepareProposal", heightAttr(req.Height))
+	res, err := s.prepareProposal(ctx, req)
+	tracing.End(span, err)
+	return res, err
 }
 
 func (s *Service) Info(context.Context,
@@ -102,7 +108,15 @@ func (s *Service) ProcessProposal(
 		return nil, s.ctx.Err()
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.processProposal(s.ctx, req)
+	ctx, span := tracing.Start(s.ctx, "ProcessProposal", heightAttr(req.Height))
+	done := s.trackSlowBlock("ProcessProposal", req.Height)
+	res, err := s.processProposal(ctx, req)
+	done()
+	if err == nil && res.Status != cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+		span.SetAttributes(attribute.String("status", res.Status.String()))
+	}
+	tracing.End(span, err)
+	return res, err
 }
 
 func (s *Service) FinalizeBlock(
@@ -116,16 +130,20 @@ func (s *Service) FinalizeBlock(
 		return nil, s.ctx.Err()
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.finalizeBlock(s.ctx, req)
+	ctx, span := tracing.Start(s.ctx, "FinalizeBlock", heightAttr(req.Height))
+	done := s.trackSlowBlock("FinalizeBlock", req.Height)
+	res, err := s.finalizeBlock(ctx, req)
+	done()
+	tracing.End(span, err)
+	return res, err
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned cmtabci.ResponseCommit. Commit will set the check state based on the
 // latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// defined in config or requested at runtime, Commit gracefully halts the node
+// once the latest committed height reaches it.
 func (s *Service) Commit(
 	_ context.Context, req *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
@@ -136,62 +154,100 @@ func (s *Service) Commit(
 		return nil, s.ctx.Err()
 	}
 
-	return s.commit(req)
+	_, span := tracing.Start(s.ctx, "Commit")
+	res, err := s.commit(req)
+	tracing.End(span, err)
+	return res, err
+}
+
+// ExtendVote implements the ExtendVote ABCI method, extending the precommit
+// of this validator with its DA attestation of the proposal. It is only
+// called by CometBFT once vote extensions are enabled.
+func (s *Service) ExtendVote(
+	_ context.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	// Check if ctx is still good. CometBFT does not check this.
+	if s.ctx.Err() != nil {
+		// If the context is getting cancelled, we are shutting down.
+		// It is ok returning an empty extension.
+		//nolint:nilerr // explicitly allowing this case
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+	//nolint:contextcheck // see s.ctx comment for more details
+	return s.extendVote(s.ctx, req)
+}
+
+// VerifyVoteExtension implements the VerifyVoteExtension ABCI method,
+// verifying the DA attestation extending the precommit of another validator.
+func (s *Service) VerifyVoteExtension(
+	_ context.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	// Check if ctx is still good. CometBFT does not check this.
+	if s.ctx.Err() != nil {
+		// We do not want to accept or reject an extension based on
+		// incomplete data.
+		return nil, s.ctx.Err()
+	}
+	//nolint:contextcheck // see s.ctx comment for more details
+	return s.verifyVoteExtension(s.ctx, req)
+}
+
+// trackSlowBlock tracks an ABCI call processing the block at the given
+// height with the profiler, if set. The returned function must be called
+// once the call returns.
+func (s *Service) trackSlowBlock(method string, height int64) func() {
+	if s.profiler == nil {
+		return func() {}
+	}
+	return s.profiler.Track(method, math.Slot(height)) // #nosec G115 // heights are positive.
+}
+
+// heightAttr is the span attribute recording the height of an ABCI request.
+func heightAttr(height int64) attribute.KeyValue {
+	return attribute.Int64("height", height)
 }
 
 //
 // NOOP methods
 //
 
-func (Service) Query(
+func (*Service) Query(
 	context.Context,
 	*abci.QueryRequest,
 ) (*abci.QueryResponse, error) {
 	return &abci.QueryResponse{}, nil
 }
 
-func (Service) ListSnapshots(
+func (*Service) ListSnapshots(
 	context.Context,
 	*abci.ListSnapshotsRequest,
 ) (*abci.ListSnapshotsResponse, error) {
 	return &abci.ListSnapshotsResponse{}, nil
 }
 
-func (Service) LoadSnapshotChunk(
+func (*Service) LoadSnapshotChunk(
 	context.Context,
 	*abci.LoadSnapshotChunkRequest,
 ) (*abci.LoadSnapshotChunkResponse, error) {
 	return &abci.LoadSnapshotChunkResponse{}, nil
 }
 
-func (Service) OfferSnapshot(
+func (*Service) OfferSnapshot(
 	context.Context,
 	*abci.OfferSnapshotRequest,
 ) (*abci.OfferSnapshotResponse, error) {
 	return &abci.OfferSnapshotResponse{}, nil
 }
 
-func (Service) ApplySnapshotChunk(
+func (*Service) ApplySnapshotChunk(
 	context.Context,
 	*abci.ApplySnapshotChunkRequest,
 ) (*abci.ApplySnapshotChunkResponse, error) {
 	return &abci.ApplySnapshotChunkResponse{}, nil
 }
 
-func (Service) ExtendVote(
-	context.Context,
-	*abci.ExtendVoteRequest,
-) (*abci.ExtendVoteResponse, error) {
-	return &abci.ExtendVoteResponse{}, nil
-}
-
-func (Service) VerifyVoteExtension(
-	context.Context,
-	*abci.VerifyVoteExtensionRequest,
-) (*abci.VerifyVoteExtensionResponse, error) {
-	return &abci.VerifyVoteExtensionResponse{}, nil
-}
-
 func (*Service) CheckTx(
 	context.Context,
 	*abci.CheckTxRequest,
@@ -46,6 +46,7 @@ func (s *Service) commit(
 	s.sm.GetCommitMultiStore().Commit()
 
 	s.finalizeBlockState = nil
+	s.haltIfRequested(header.Height)
 
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
@@ -26,23 +26,32 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
-// request.
+// request, unwrapping them from the compressed envelope if compressed is set.
+// Each tx may decode to at most maxTxSize bytes.
 func ExtractBlobsAndBlockFromRequest(
 	req ABCIRequest,
 	beaconBlkIndex uint,
 	blobSidecarsIndex uint,
 	forkVersion common.Version,
+	compressed bool,
+	maxTxSize int64,
 ) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
 	if req == nil {
 		return nil, nil, ErrNilABCIRequest
 	}
 
+	txs, err := envelope.DecodeTxs(req.GetTxs(), compressed, maxTxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	blk, err := UnmarshalBeaconBlockFromABCIRequest(
-		req.GetTxs(),
+		txs,
 		beaconBlkIndex,
 		forkVersion,
 	)
@@ -51,7 +60,7 @@ func ExtractBlobsAndBlockFromRequest(
 	}
 
 	blobs, err := UnmarshalBlobSidecarsFromABCIRequest(
-		req.GetTxs(),
+		txs,
 		blobSidecarsIndex,
 	)
 
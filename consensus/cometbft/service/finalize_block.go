@@ -47,6 +47,9 @@ func (s *Service) finalizeBlockInternal(
 	if err := s.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
+	if err := s.checkHalt(req.Height, req.Time); err != nil {
+		return nil, err
+	}
 
 	// finalizeBlockState should be set on InitChain or ProcessProposal. If it
 	// is nil, it means we are replaying this block and we need to set the state
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/primitives/math"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+)
+
+var errHaltHeightCommitted = errors.New("halt height already committed")
+
+// RequestHalt schedules a graceful shutdown of the node once the block at the
+// given height has been committed. Blocks above the halt height are refused,
+// so the node cannot progress past it even if the shutdown is delayed.
+func (s *Service) RequestHalt(height int64) error {
+	if last := s.LastBlockHeight(); height <= last {
+		return fmt.Errorf(
+			"halt at height %d, last committed height %d: %w",
+			height, last, errHaltHeightCommitted,
+		)
+	}
+	s.requestedHaltHeight.Store(height)
+	s.logger.Info("Scheduled node halt", "height", height)
+	return nil
+}
+
+// loadHaltMarker enforces the halt marker left by a previous run, if any.
+// The node refuses to progress past the marked height unless its binary has
+// been upgraded, in which case the marker is cleared along with the halt
+// configuration that it fulfilled.
+func (s *Service) loadHaltMarker() error {
+	if s.haltMarkerPath == "" {
+		return nil
+	}
+	marker, err := halt.ReadMarker(s.haltMarkerPath)
+	if err != nil || marker == nil {
+		return err
+	}
+
+	if !marker.UpgradedTo(sdkversion.Version, s.forkVersionAt) {
+		s.haltMarker = marker
+		s.haltCfg.Height = uint64(max(marker.Height, 0))
+		s.logger.Warn(
+			"Node halted at this height, upgrade the binary to progress past it",
+			"height", marker.Height,
+			"version", marker.Version,
+			"marker", s.haltMarkerPath,
+		)
+		return nil
+	}
+
+	if resumed := marker.Resume(s.haltCfg); resumed != s.haltCfg {
+		s.logger.Warn(
+			"Ignoring halt configuration reached before upgrade",
+			"halt_height", s.haltCfg.Height, "halt_time", s.haltCfg.Time,
+		)
+		s.haltCfg = resumed
+	}
+	if err = os.Remove(s.haltMarkerPath); err != nil {
+		return fmt.Errorf("failed to remove halt marker: %w", err)
+	}
+	s.logger.Info(
+		"Resuming past halt with upgraded binary",
+		"height", marker.Height,
+		"previous_version", marker.Version,
+		"version", sdkversion.Version,
+	)
+	return nil
+}
+
+// logHaltConfig logs when the node is configured to halt, along with the fork
+// scheduled at the halt time, if any.
+func (s *Service) logHaltConfig() {
+	if s.haltCfg.Height != 0 {
+		s.logger.Info("Node will halt after committing height", "halt_height", s.haltCfg.Height)
+	}
+	if s.haltCfg.Time == 0 {
+		return
+	}
+	var forks []chain.ScheduledFork
+	if s.chainSpec != nil {
+		forks = s.chainSpec.ForkSchedule()
+	}
+	for _, fork := range forks {
+		if fork.Timestamp == s.haltCfg.Time {
+			s.logger.Info(
+				"Node will halt before fork activation",
+				"halt_time", s.haltCfg.Time, "fork", fork.Name,
+			)
+			return
+		}
+	}
+	s.logger.Info("Node will halt before block time", "halt_time", s.haltCfg.Time)
+}
+
+// checkHalt returns an error if the block at the given height and time must
+// not be finalized, as the node halts before it. The node is then halted.
+func (s *Service) checkHalt(height int64, blockTime time.Time) error {
+	if err := s.haltCfg.Check(height, blockTime); err != nil {
+		s.halt(s.LastBlockHeight(), true)
+		return fmt.Errorf("finalizeBlock: %w", err)
+	}
+	if requested := s.requestedHaltHeight.Load(); requested != 0 && height > requested {
+		s.halt(s.LastBlockHeight(), false)
+		return fmt.Errorf(
+			"finalizeBlock at height %d, requested halt height %d: %w",
+			height, requested, halt.ErrHeightReached,
+		)
+	}
+	return nil
+}
+
+// haltIfRequested halts the node once the block at the halt height has been
+// committed.
+func (s *Service) haltIfRequested(height int64) {
+	switch requested := s.requestedHaltHeight.Load(); {
+	case s.haltCfg.Reached(height):
+		s.halt(height, true)
+	case requested != 0 && height >= requested:
+		s.halt(height, false)
+	}
+}
+
+// halt shuts the node down gracefully, the last committed height being the
+// given one. The deposit and blob stores are flushed first and, if the halt
+// was configured, a marker is persisted so that the node refuses to progress
+// past the height until its binary is upgraded.
+func (s *Service) halt(height int64, persistMarker bool) {
+	if !s.halting.CompareAndSwap(false, true) {
+		return
+	}
+	s.logger.Info("Halting node", "height", height)
+
+	if err := s.Blockchain.FlushStores(); err != nil {
+		s.logger.Error("Failed to flush stores before halting", "height", height, "error", err)
+	}
+	if persistMarker && s.haltMarker == nil {
+		if err := s.writeHaltMarker(height); err != nil {
+			s.logger.Error("Failed to persist halt marker", "height", height, "error", err)
+		}
+	}
+
+	// Interrupt ourselves so that the node goes through its regular shutdown,
+	// stopping every service in order.
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		err = p.Signal(syscall.SIGINT)
+	}
+	if err != nil {
+		s.logger.Error("Failed to halt node", "height", height, "error", err)
+	}
+}
+
+// writeHaltMarker persists the halt marker at the given height.
+func (s *Service) writeHaltMarker(height int64) error {
+	if s.haltMarkerPath == "" {
+		return nil
+	}
+	marker := &halt.Marker{
+		Height:     height,
+		HaltHeight: s.haltCfg.Height,
+		HaltTime:   s.haltCfg.Time,
+		Version:    sdkversion.Version,
+	}
+	if s.haltCfg.Time != 0 {
+		marker.ForkVersion = s.forkVersionAt(s.haltCfg.Time)
+	}
+	if err := marker.Write(s.haltMarkerPath); err != nil {
+		return err
+	}
+	s.haltMarker = marker
+	s.logger.Info("Persisted halt marker", "height", height, "marker", s.haltMarkerPath)
+	return nil
+}
+
+// forkVersionAt returns the fork version active at the given time.
+func (s *Service) forkVersionAt(timestamp uint64) string {
+	if s.chainSpec == nil {
+		return ""
+	}
+	return s.chainSpec.ActiveForkVersionForTimestamp(math.U64(timestamp)).String()
+}
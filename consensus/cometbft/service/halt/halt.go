@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package halt implements the halts of the node configured by operators, at
+// a height or from a block time, along with the marker which prevents the
+// node from progressing past a halt until its binary is upgraded.
+package halt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+var (
+	// ErrHeightReached is returned when a block is above the halt height.
+	ErrHeightReached = errors.New("halt height reached")
+	// ErrTimeReached is returned when a block may carry a payload timestamped
+	// at or after the halt time.
+	ErrTimeReached = errors.New("halt time reached")
+)
+
+// Config is the halt configured by the operator. Zero values are unset.
+type Config struct {
+	// Height is the height after which the node halts.
+	Height uint64
+	// Time is the block time, in unix seconds, from which the node halts.
+	Time uint64
+}
+
+// Check returns an error if the block at the given height and time must not
+// be finalized, as the node halts before it.
+//
+// Unlike the halt height, the halt time is enforced before the block is
+// finalized, so that a halt time set to a fork timestamp stops the node
+// before the fork activates.
+func (c Config) Check(height int64, blockTime time.Time) error {
+	// #nosec G115 // height is positive.
+	if c.Height != 0 && height > 0 && uint64(height) > c.Height {
+		return fmt.Errorf("height %d, halt height %d: %w",
+			height, c.Height, ErrHeightReached,
+		)
+	}
+	if c.Time != 0 && CrossesTime(blockTime, c.Time) {
+		return fmt.Errorf("block time %d, halt time %d: %w",
+			blockTime.Unix(), c.Time, ErrTimeReached,
+		)
+	}
+	return nil
+}
+
+// Reached returns whether the node halts once the block at the given height
+// is committed.
+func (c Config) Reached(height int64) bool {
+	// #nosec G115 // height is positive.
+	return c.Height != 0 && height > 0 && uint64(height) >= c.Height
+}
+
+// IsZero returns whether no halt is configured.
+func (c Config) IsZero() bool {
+	return c.Height == 0 && c.Time == 0
+}
+
+// CrossesTime returns whether a block at the given time may carry a payload
+// timestamped at or after the halt time. A payload may be timestamped up to a
+// second after its block, see payloadtime.Verify.
+func CrossesTime(blockTime time.Time, haltTime uint64) bool {
+	latest := blockTime.Unix() + 1
+	// #nosec G115 // latest is positive.
+	return latest > 0 && uint64(latest) >= haltTime
+}
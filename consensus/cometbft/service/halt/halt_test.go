@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package halt_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigCheck(t *testing.T) {
+	t.Parallel()
+	const haltTime = 1_000
+
+	tests := []struct {
+		name        string
+		cfg         halt.Config
+		height      int64
+		blockTime   int64
+		expectedErr error
+	}{
+		{
+			name:      "no halt configured",
+			height:    100,
+			blockTime: haltTime,
+		},
+		{
+			name:      "block at halt height is finalized",
+			cfg:       halt.Config{Height: 10},
+			height:    10,
+			blockTime: 1,
+		},
+		{
+			name:        "block above halt height is refused",
+			cfg:         halt.Config{Height: 10},
+			height:      11,
+			blockTime:   1,
+			expectedErr: halt.ErrHeightReached,
+		},
+		{
+			name:      "block before halt time is finalized",
+			cfg:       halt.Config{Time: haltTime},
+			height:    1,
+			blockTime: haltTime - 2,
+		},
+		{
+			name:        "block whose payload may cross halt time is refused",
+			cfg:         halt.Config{Time: haltTime},
+			height:      1,
+			blockTime:   haltTime - 1,
+			expectedErr: halt.ErrTimeReached,
+		},
+		{
+			name:        "block after halt time is refused",
+			cfg:         halt.Config{Time: haltTime},
+			height:      1,
+			blockTime:   haltTime + 10,
+			expectedErr: halt.ErrTimeReached,
+		},
+		{
+			name:        "halt time applies before halt height",
+			cfg:         halt.Config{Height: 10, Time: haltTime},
+			height:      5,
+			blockTime:   haltTime,
+			expectedErr: halt.ErrTimeReached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.cfg.Check(tt.height, time.Unix(tt.blockTime, 0))
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigReached(t *testing.T) {
+	t.Parallel()
+	cfg := halt.Config{Height: 10}
+	require.False(t, cfg.Reached(9))
+	require.True(t, cfg.Reached(10))
+	require.True(t, cfg.Reached(11))
+	require.False(t, halt.Config{Time: 1_000}.Reached(10))
+}
+
+func TestCrossesTime(t *testing.T) {
+	t.Parallel()
+	require.False(t, halt.CrossesTime(time.Unix(998, 0), 1_000))
+	require.True(t, halt.CrossesTime(time.Unix(999, 0), 1_000))
+	require.True(t, halt.CrossesTime(time.Unix(1_000, 0), 1_000))
+	require.False(t, halt.CrossesTime(time.Unix(-10, 0), 1))
+}
+
+func TestMarkerRestart(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "data", "halt.json")
+
+	// No marker is left before the node halts.
+	marker, err := halt.ReadMarker(path)
+	require.NoError(t, err)
+	require.Nil(t, marker)
+
+	// The marker written when halting is read back on restart.
+	written := &halt.Marker{
+		Height:      10,
+		HaltHeight:  10,
+		HaltTime:    1_000,
+		ForkVersion: "0x05000000",
+		Version:     "v1.0.0",
+	}
+	require.NoError(t, written.Write(path))
+	marker, err = halt.ReadMarker(path)
+	require.NoError(t, err)
+	require.Equal(t, written, marker)
+
+	// Once upgraded, the halts fulfilled by the marker are cleared.
+	require.Equal(t, halt.Config{}, marker.Resume(halt.Config{Height: 10, Time: 1_000}))
+	require.Equal(t,
+		halt.Config{Height: 20, Time: 2_000},
+		marker.Resume(halt.Config{Height: 20, Time: 2_000}),
+	)
+}
+
+func TestMarkerUpgradedTo(t *testing.T) {
+	t.Parallel()
+	forkVersions := func(version string) func(uint64) string {
+		return func(uint64) string { return version }
+	}
+
+	tests := []struct {
+		name          string
+		marker        halt.Marker
+		version       string
+		forkVersionAt func(uint64) string
+		expected      bool
+	}{
+		{
+			name:          "same binary halted at height",
+			marker:        halt.Marker{Height: 10, Version: "v1.0.0"},
+			version:       "v1.0.0",
+			forkVersionAt: forkVersions("0x05000000"),
+			expected:      false,
+		},
+		{
+			name:          "new version",
+			marker:        halt.Marker{Height: 10, Version: "v1.0.0"},
+			version:       "v1.1.0",
+			forkVersionAt: forkVersions("0x05000000"),
+			expected:      true,
+		},
+		{
+			name: "same binary halted before fork",
+			marker: halt.Marker{
+				Height: 10, HaltTime: 1_000, ForkVersion: "0x05000000", Version: "v1.0.0",
+			},
+			version:       "v1.0.0",
+			forkVersionAt: forkVersions("0x05000000"),
+			expected:      false,
+		},
+		{
+			name: "same version scheduling another fork at halt time",
+			marker: halt.Marker{
+				Height: 10, HaltTime: 1_000, ForkVersion: "0x05000000", Version: "v1.0.0",
+			},
+			version:       "v1.0.0",
+			forkVersionAt: forkVersions("0x05010000"),
+			expected:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, tt.marker.UpgradedTo(tt.version, tt.forkVersionAt))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package halt
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// Marker is persisted once the node halts per configuration, so that the node
+// refuses to progress past the halt until its binary is upgraded.
+type Marker struct {
+	// Height is the last height committed before halting.
+	Height int64 `json:"height"`
+	// HaltHeight is the configured halt height, if any.
+	HaltHeight uint64 `json:"halt_height,omitempty"`
+	// HaltTime is the configured halt time, if any.
+	HaltTime uint64 `json:"halt_time,omitempty"`
+	// ForkVersion is the fork version active at the halt time according to
+	// the chain spec of the binary that halted.
+	ForkVersion string `json:"fork_version,omitempty"`
+	// Version is the version of the binary that halted.
+	Version string `json:"version"`
+}
+
+// ReadMarker reads the marker at the given path. It returns nil if there is
+// no marker.
+func ReadMarker(path string) (*Marker, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read halt marker")
+	}
+	m := new(Marker)
+	if err = json.Unmarshal(bz, m); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse halt marker %s", path)
+	}
+	return m, nil
+}
+
+// Write persists the marker at the given path.
+func (m *Marker) Write(path string) error {
+	bz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// UpgradedTo returns whether the binary of the given version, resolving the
+// fork version at the halt time with forkVersionAt, differs from the one that
+// left the marker.
+func (m *Marker) UpgradedTo(version string, forkVersionAt func(uint64) string) bool {
+	if m.Version != version {
+		return true
+	}
+	return m.HaltTime != 0 && m.ForkVersion != forkVersionAt(m.HaltTime)
+}
+
+// Resume returns the given halt configuration without the halts fulfilled by
+// the marker, once the binary has been upgraded.
+func (m *Marker) Resume(cfg Config) Config {
+	// #nosec G115 // height is positive.
+	if cfg.Height != 0 && m.Height > 0 && cfg.Height <= uint64(m.Height) {
+		cfg.Height = 0
+	}
+	if cfg.Time != 0 && cfg.Time <= m.HaltTime {
+		cfg.Time = 0
+	}
+	return cfg
+}
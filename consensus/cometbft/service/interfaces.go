@@ -22,6 +22,10 @@ package cometbft
 
 import (
 	"time"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -29,3 +33,20 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
 }
+
+// Profiler captures profiles of the blocks that are slow to process.
+type Profiler interface {
+	// Track tracks a call of the given ABCI method processing the block at
+	// the given slot. The returned function must be called once the call
+	// returns.
+	Track(method string, slot math.Slot) func()
+}
+
+// ForkSpec gives access to the fork schedule of the chain.
+type ForkSpec interface {
+	// ActiveForkVersionForTimestamp returns the fork version active at the
+	// given timestamp.
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	// ForkSchedule returns the scheduled forks, ordered by timestamp.
+	ForkSchedule() []chain.ScheduledFork
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var errNodeNotStarted = errors.New("cometbft node not started")
+
+// LightBlock returns the CometBFT light block, i.e. the signed header and the
+// validator set, at the given height along with the proof of inclusion of the
+// transaction at txIndex in the block. A height of 0 returns the latest block.
+func (s *Service) LightBlock(
+	height int64,
+	txIndex int,
+) (*cmttypes.LightBlock, *cmttypes.TxProof, error) {
+	env := s.rpcEnv
+	if env == nil {
+		return nil, nil, errNodeNotStarted
+	}
+
+	latest := env.BlockStore.Height()
+	if height == 0 {
+		height = latest
+	}
+	if height < env.BlockStore.Base() || height > latest {
+		return nil, nil, fmt.Errorf(
+			"%w: height %d, available [%d, %d]",
+			errInvalidHeight, height, env.BlockStore.Base(), latest,
+		)
+	}
+
+	block, _ := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("%w: block %d not found", errInvalidHeight, height)
+	}
+	if txIndex >= len(block.Txs) {
+		return nil, nil, fmt.Errorf("tx index %d out of range, block %d has %d txs", txIndex, height, len(block.Txs))
+	}
+
+	// The commit of the latest block is only available as seen by this node.
+	commit := env.BlockStore.LoadBlockCommit(height)
+	if height == latest {
+		commit = env.BlockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, nil, fmt.Errorf("%w: commit %d not found", errInvalidHeight, height)
+	}
+
+	vals, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed loading validators at height %d: %w", height, err)
+	}
+
+	lightBlock := &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{
+			Header: &block.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}
+	proof := block.Txs.Proof(txIndex)
+	return lightBlock, &proof, nil
+}
@@ -83,3 +83,32 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Servic
 func SetChainID(chainID string) func(*Service) {
 	return func(s *Service) { s.chainID = chainID }
 }
+
+// SetProfiler sets the profiler capturing profiles of slow blocks.
+func SetProfiler(profiler Profiler) func(*Service) {
+	return func(s *Service) { s.profiler = profiler }
+}
+
+// SetHaltHeight returns a Service option function that sets the height after
+// which the node halts.
+func SetHaltHeight(height uint64) func(*Service) {
+	return func(s *Service) { s.haltCfg.Height = height }
+}
+
+// SetHaltTime returns a Service option function that sets the block time, in
+// unix seconds, from which the node halts.
+func SetHaltTime(haltTime uint64) func(*Service) {
+	return func(s *Service) { s.haltCfg.Time = haltTime }
+}
+
+// SetHaltMarker returns a Service option function that sets where the marker
+// of a configured halt is persisted.
+func SetHaltMarker(path string) func(*Service) {
+	return func(s *Service) { s.haltMarkerPath = path }
+}
+
+// SetForkSpec returns a Service option function that sets the chain spec
+// used to resolve forks around the halt time.
+func SetForkSpec(spec ForkSpec) func(*Service) {
+	return func(s *Service) { s.chainSpec = spec }
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
@@ -66,22 +67,42 @@ func (s *Service) prepareProposal(
 		req.GetTime(),
 	)
 
+	// Deposits are read more than once while the block is built, so they must
+	// not be changed out of band in between.
+	blockLock := s.Blockchain.BlockLocker()
+	blockLock.Lock()
 	//nolint:contextcheck // ctx already passed via resetState
 	blkBz, sidecarsBz, err := s.BlockBuilder.BuildBlockAndSidecars(
 		s.prepareProposalState.Context(),
 		slotData,
 	)
+	blockLock.Unlock()
 	if err != nil {
 		s.logger.Error(
 			"failed to prepare proposal",
 			"height", req.Height,
 			"time", req.Time,
-			"err", err,
+			log.KeyError, err,
 		)
 		return &cmtabci.PrepareProposalResponse{Txs: [][]byte{}}, nil
 	}
+	txs := [][]byte{blkBz, sidecarsBz}
 
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	// Once vote extensions are enabled, the DA attestations extending the
+	// last commit are aggregated in the proposal.
+	if req.Height > s.initialHeight &&
+		s.cmtConsensusParams.Feature.VoteExtensionsEnabled(req.Height-1) {
+		attestationsBz, errAtt := s.Blockchain.BuildDAAttestationsTx(req)
+		if errAtt != nil {
+			s.logger.Error(
+				"failed to aggregate DA attestations",
+				"height", req.Height,
+				log.KeyError, errAtt,
+			)
+			return &cmtabci.PrepareProposalResponse{Txs: [][]byte{}}, nil
+		}
+		txs = append(txs, attestationsBz)
+	}
+
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
 }
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/log"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -80,7 +81,7 @@ func (s *Service) processProposal(
 			"height", req.Height,
 			"time", req.Time,
 			"hash", fmt.Sprintf("%X", req.Hash),
-			"err", err,
+			log.KeyError, err,
 		)
 	}
 	return &cmtabci.ProcessProposalResponse{Status: status}, nil
@@ -18,42 +18,20 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package telemetry
+package cometbft
 
-import (
-	"context"
+import "fmt"
 
-	"github.com/cosmos/cosmos-sdk/telemetry"
-)
-
-type Config = telemetry.Config
-
-// Service is a telemetry service.
-type Service struct {
-	m *telemetry.Metrics
-}
-
-// NewService creates a new telemetry service.
-func NewService(cfg *telemetry.Config) (*Service, error) {
-	m, err := telemetry.New(*cfg)
+// ExpectedProposer returns the CometBFT address of the validator scheduled to
+// propose the block at the given height in its first round.
+func (s *Service) ExpectedProposer(height int64) ([]byte, error) {
+	env := s.rpcEnv
+	if env == nil {
+		return nil, errNodeNotStarted
+	}
+	vals, err := env.StateStore.LoadValidators(height)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed loading validators at height %d: %w", height, err)
 	}
-	return &Service{
-		m: m,
-	}, nil
-}
-
-// Name returns the service name.
-func (s *Service) Name() string {
-	return "telemetry"
-}
-
-// Start starts the telemetry service.
-func (s *Service) Start(context.Context) error {
-	return nil
-}
-
-func (s *Service) Stop() error {
-	return nil
+	return vals.GetProposer().Address, nil
 }
@@ -24,13 +24,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	statem "github.com/berachain/beacon-kit/consensus/cometbft/service/state"
 	errorsmod "github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -41,6 +44,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +59,10 @@ const (
 type Service struct {
 	node *node.Node
 
+	// rpcEnv gives access to the block and state stores of node.
+	// It is set once node is created.
+	rpcEnv *rpccore.Environment
+
 	// cmtConsensusParams are part of the blockchain state and
 	// are agreed upon by all validators in the network.
 	cmtConsensusParams *cmttypes.ConsensusParams
@@ -65,6 +73,8 @@ type Service struct {
 	cmtCfg *cmtcfg.Config
 
 	telemetrySink TelemetrySink
+	// profiler captures profiles of slow blocks, it is nil if unset.
+	profiler Profiler
 
 	logger       *phuslu.Logger
 	sm           *statem.Manager
@@ -97,6 +107,21 @@ type Service struct {
 
 	chainID string
 
+	// haltCfg is the halt configured by the operator.
+	haltCfg halt.Config
+	// haltMarkerPath is where the marker of a configured halt is persisted.
+	haltMarkerPath string
+	// haltMarker is the marker of a configured halt the node is bound by, nil
+	// if none.
+	haltMarker *halt.Marker
+	// requestedHaltHeight is the height after which the node shuts down per
+	// runtime request, zero if no halt has been requested.
+	requestedHaltHeight atomic.Int64
+	// halting is set once the node started halting.
+	halting atomic.Bool
+	// chainSpec gives access to the fork schedule, it is nil if unset.
+	chainSpec ForkSpec
+
 	// ctx is the context passed in for the service. CometBFT currently does
 	// not support context usage. It passes "context.TODO()" to apps that
 	// implement the ABCI++ interface, and does not provide a context that is
@@ -176,6 +201,11 @@ func (s *Service) Start(
 		return err
 	}
 
+	if err = s.loadHaltMarker(); err != nil {
+		return err
+	}
+	s.logHaltConfig()
+
 	s.ResetAppCtx(ctx)
 	s.node, err = node.NewNode(
 		ctx,
@@ -186,11 +216,14 @@ func (s *Service) Start(
 		GetGenDocProvider(cfg),
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
-		servercmtlog.WrapCometLogger(s.logger),
+		servercmtlog.WrapCometLogger(s.logger.With(log.KeyService, "comet")),
 	)
 	if err != nil {
 		return err
 	}
+	if s.rpcEnv, err = s.node.ConfigureRPC(); err != nil {
+		return err
+	}
 
 	started := make(chan struct{})
 
@@ -294,7 +327,10 @@ func (s *Service) resetState(ctx context.Context) *state {
 		ms,
 		false,
 		servercmtlog.WrapSDKLogger(s.logger),
-	).WithContext(ctx)
+	).
+		WithContext(ctx).
+		WithChainID(s.chainID).
+		WithConsensusParams(s.cmtConsensusParams.ToProto())
 
 	return &state{
 		ms:  ms,
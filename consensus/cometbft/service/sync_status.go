@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// peerHeight is implemented by the consensus state CometBFT keeps for every
+// peer.
+type peerHeight interface {
+	GetHeight() int64
+}
+
+// IsCatchingUp reports whether CometBFT is block syncing or state syncing
+// rather than taking part in consensus. A node not started yet is catching up.
+func (s *Service) IsCatchingUp() bool {
+	env := s.rpcEnv
+	if env == nil {
+		return true
+	}
+	return env.ConsensusReactor.WaitSync()
+}
+
+// NetworkHeight returns the latest height committed by the network as seen
+// from the consensus state of the peers, or the latest height committed by
+// the node if no peer is ahead of it.
+func (s *Service) NetworkHeight() int64 {
+	height := s.LastBlockHeight()
+	env := s.rpcEnv
+	if env == nil {
+		return height
+	}
+	env.P2PPeers.Peers().ForEach(func(peer p2p.Peer) {
+		ps, ok := peer.Get(cmttypes.PeerStateKey).(peerHeight)
+		if !ok {
+			return
+		}
+		// Peers report the height they are deciding, one past their latest
+		// committed height.
+		height = max(height, ps.GetHeight()-1)
+	})
+	return height
+}
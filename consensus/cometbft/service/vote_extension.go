@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+func (s *Service) extendVote(
+	ctx context.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	pubKey, err := s.node.PrivValidator().GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("extendVote at height %v: %w", req.Height, err)
+	}
+
+	// Failing to attest must not prevent the node from precommitting, so
+	// errors result in an empty extension which other validators accept but
+	// do not count as an attestation.
+	//nolint:contextcheck // ctx already passed via resetState
+	extension, err := s.Blockchain.ExtendVote(
+		s.resetState(ctx).Context(),
+		req,
+		pubKey.Address(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"failed to extend vote",
+			"height", req.Height,
+			"hash", fmt.Sprintf("%X", req.Hash),
+			log.KeyError, err,
+		)
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+	return &cmtabci.ExtendVoteResponse{VoteExtension: extension}, nil
+}
+
+func (s *Service) verifyVoteExtension(
+	ctx context.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	status := cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT
+	//nolint:contextcheck // ctx already passed via resetState
+	err := s.Blockchain.VerifyVoteExtension(s.resetState(ctx).Context(), req)
+	if err != nil {
+		status = cmtabci.VERIFY_VOTE_EXTENSION_STATUS_REJECT
+		s.logger.Error(
+			"failed to verify vote extension",
+			"height", req.Height,
+			"validator", fmt.Sprintf("%X", req.ValidatorAddress),
+			log.KeyError, err,
+		)
+	}
+	return &cmtabci.VerifyVoteExtensionResponse{Status: status}, nil
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package attestation implements the data availability attestations carried by
+// CometBFT vote extensions.
+//
+// When vote extensions are enabled, every validator precommitting a block
+// extends its vote with an attestation that it holds and verified the blob
+// sidecars of that block. The next proposer includes the extended commit it
+// collected as an additional consensus transaction, and the block is only
+// accepted if more than two thirds of the voting power of the last commit
+// attested the availability of its parent.
+package attestation
+
+import (
+	"bytes"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ValidatorResolver returns the index and public key of the validator with the
+// given CometBFT address.
+type ValidatorResolver func(
+	cometBFTAddress []byte,
+) (math.ValidatorIndex, crypto.BLSPubkey, error)
+
+// New returns the attestation of the validator at index for the beacon block
+// with the given root, proposed at the given CometBFT height.
+func New(
+	height int64,
+	index math.ValidatorIndex,
+	blockRoot common.Root,
+) *ctypes.AttestationData {
+	return &ctypes.AttestationData{
+		Slot:            math.Slot(height), // #nosec G115 // heights are positive.
+		Index:           index,
+		BeaconBlockRoot: blockRoot,
+	}
+}
+
+// Decode decodes the attestation carried by a vote extension.
+func Decode(bz []byte) (*ctypes.AttestationData, error) {
+	if len(bz) != ctypes.AttestationDataSize {
+		return nil, fmt.Errorf("%w: %d bytes, expected %d",
+			ErrInvalidAttestation, len(bz), ctypes.AttestationDataSize,
+		)
+	}
+	att := new(ctypes.AttestationData)
+	if err := ssz.Unmarshal(bz, att); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAttestation, err)
+	}
+	return att, nil
+}
+
+// VerifyExtension verifies the vote extension of the validator with the given
+// CometBFT address at height, returning the attestation it carries. A
+// validator does not attest proposals it did not verify, e.g. after a restart,
+// so an empty extension is valid and carries no attestation.
+func VerifyExtension(
+	extension []byte,
+	height int64,
+	cometBFTAddress []byte,
+	resolve ValidatorResolver,
+) (*ctypes.AttestationData, error) {
+	if len(extension) == 0 {
+		return nil, nil
+	}
+	att, err := Decode(extension)
+	if err != nil {
+		return nil, err
+	}
+	if att.GetSlot() != math.Slot(height) { // #nosec G115 // heights are positive.
+		return nil, fmt.Errorf("%w: slot %d, height %d",
+			ErrInvalidAttestation, att.GetSlot(), height,
+		)
+	}
+	index, _, err := resolve(cometBFTAddress)
+	if err != nil {
+		return nil, err
+	}
+	if att.GetIndex() != index {
+		return nil, fmt.Errorf("%w: index %d, validator index %d",
+			ErrInvalidAttestation, att.GetIndex(), index,
+		)
+	}
+	return att, nil
+}
+
+// VerifyExtendedCommit verifies the extended commit aggregated by the proposer
+// against the last commit of its proposal, and that the validators attesting
+// the availability of the beacon block with the given root at height hold
+// more than two thirds of the voting power of the last commit.
+func VerifyExtendedCommit(
+	chainID string,
+	height int64,
+	extCommit *cmtabci.ExtendedCommitInfo,
+	lastCommit *cmtabci.CommitInfo,
+	blockRoot common.Root,
+	resolve ValidatorResolver,
+) (int64, error) {
+	if extCommit.Round != lastCommit.Round {
+		return 0, fmt.Errorf("%w: round %d, last commit round %d",
+			ErrInvalidExtendedCommit, extCommit.Round, lastCommit.Round,
+		)
+	}
+	if len(extCommit.Votes) != len(lastCommit.Votes) {
+		return 0, fmt.Errorf("%w: %d votes, last commit has %d",
+			ErrInvalidExtendedCommit, len(extCommit.Votes), len(lastCommit.Votes),
+		)
+	}
+
+	var totalPower, attestedPower int64
+	for i := range extCommit.Votes {
+		vote, expected := &extCommit.Votes[i], &lastCommit.Votes[i]
+		if !bytes.Equal(vote.Validator.Address, expected.Validator.Address) ||
+			vote.Validator.Power != expected.Validator.Power ||
+			vote.BlockIdFlag != expected.BlockIdFlag {
+			return 0, fmt.Errorf("%w: vote %d does not match the last commit",
+				ErrInvalidExtendedCommit, i,
+			)
+		}
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		index, pubkey, err := resolve(vote.Validator.Address)
+		if err != nil {
+			return 0, fmt.Errorf("failed resolving validator %X: %w", vote.Validator.Address, err)
+		}
+		if err = verifyExtensionSignature(chainID, height, extCommit.Round, vote, pubkey); err != nil {
+			return 0, fmt.Errorf("vote %d: %w", i, err)
+		}
+
+		// A validator may not attest, e.g. if it restarted since processing
+		// the proposal, or sign an attestation for another block. Such a
+		// vote is valid but not counted.
+		if len(vote.VoteExtension) == 0 {
+			continue
+		}
+		att, err := Decode(vote.VoteExtension)
+		if err != nil ||
+			att.GetSlot() != math.Slot(height) || // #nosec G115 // heights are positive.
+			att.GetIndex() != index ||
+			att.GetBeaconBlockRoot() != blockRoot {
+			continue
+		}
+		attestedPower += vote.Validator.Power
+	}
+
+	// Integer form of attestedPower > 2/3 * totalPower.
+	//nolint:mnd // two thirds.
+	if attestedPower*3 <= totalPower*2 {
+		return attestedPower, fmt.Errorf("%w: %d of %d voting power",
+			ErrInsufficientAttestations, attestedPower, totalPower,
+		)
+	}
+	return attestedPower, nil
+}
+
+// verifyExtensionSignature verifies the signature of a vote extension as
+// CometBFT does when receiving a precommit.
+func verifyExtensionSignature(
+	chainID string,
+	height int64,
+	round int32,
+	vote *cmtabci.ExtendedVoteInfo,
+	pubkey crypto.BLSPubkey,
+) error {
+	pk, err := bls12381.NewPublicKeyFromCompressedBytes(pubkey[:])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidExtendedCommit, err)
+	}
+	signBytes := cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    height,
+		Round:     round,
+		Extension: vote.VoteExtension,
+	})
+	if !pk.VerifySignature(signBytes, vote.ExtensionSignature) {
+		return fmt.Errorf("%w: invalid extension signature of validator %X",
+			ErrInvalidExtendedCommit, vote.Validator.Address,
+		)
+	}
+	return nil
+}
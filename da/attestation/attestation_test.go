@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package attestation_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/da/attestation"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chainID = "test-chain"
+	height  = int64(10)
+	round   = int32(1)
+)
+
+// testValidators holds the keys of a set of validators with equal power.
+type testValidators struct {
+	keys []*bls12381.PrivKey
+}
+
+func newTestValidators(t *testing.T, n int) *testValidators {
+	t.Helper()
+	vals := &testValidators{}
+	for range n {
+		key, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		vals.keys = append(vals.keys, key)
+	}
+	return vals
+}
+
+func (v *testValidators) resolve(
+	address []byte,
+) (math.ValidatorIndex, crypto.BLSPubkey, error) {
+	for i, key := range v.keys {
+		pk := key.PubKey()
+		if pk.Address().String() != cmttypes.Address(address).String() {
+			continue
+		}
+		compressed, ok := pk.(interface{ Compress() []byte })
+		if !ok {
+			return 0, crypto.BLSPubkey{}, errors.New("unexpected public key type")
+		}
+		return math.ValidatorIndex(i), crypto.BLSPubkey(compressed.Compress()), nil
+	}
+	return 0, crypto.BLSPubkey{}, errors.New("unknown validator")
+}
+
+// commits returns the last commit of the validators, all voting for the
+// block, along with the extended commit attesting the given root.
+func (v *testValidators) commits(
+	t *testing.T,
+	blockRoot common.Root,
+) (*cmtabci.ExtendedCommitInfo, *cmtabci.CommitInfo) {
+	t.Helper()
+	extCommit := &cmtabci.ExtendedCommitInfo{Round: round}
+	lastCommit := &cmtabci.CommitInfo{Round: round}
+	for i, key := range v.keys {
+		validator := cmtabci.Validator{Address: key.PubKey().Address(), Power: 10}
+		extension, err := attestation.New(height, math.ValidatorIndex(i), blockRoot).MarshalSSZ()
+		require.NoError(t, err)
+		extCommit.Votes = append(extCommit.Votes, cmtabci.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      extension,
+			ExtensionSignature: v.sign(t, i, extension),
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+		lastCommit.Votes = append(lastCommit.Votes, cmtabci.VoteInfo{
+			Validator:   validator,
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		})
+	}
+	return extCommit, lastCommit
+}
+
+func (v *testValidators) sign(t *testing.T, i int, extension []byte) []byte {
+	t.Helper()
+	sig, err := v.keys[i].Sign(cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    height,
+		Round:     round,
+		Extension: extension,
+	}))
+	require.NoError(t, err)
+	return sig
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	att := attestation.New(height, 3, common.Root{0x01})
+	bz, err := att.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := attestation.Decode(bz)
+	require.NoError(t, err)
+	require.Equal(t, att, decoded)
+
+	_, err = attestation.Decode(bz[1:])
+	require.ErrorIs(t, err, attestation.ErrInvalidAttestation)
+	_, err = attestation.Decode(nil)
+	require.ErrorIs(t, err, attestation.ErrInvalidAttestation)
+}
+
+func TestVerifyExtension(t *testing.T) {
+	t.Parallel()
+	vals := newTestValidators(t, 2)
+	address := vals.keys[1].PubKey().Address()
+	attestationBytes := func(slot int64, index math.ValidatorIndex) []byte {
+		bz, err := attestation.New(slot, index, common.Root{0xaa}).MarshalSSZ()
+		require.NoError(t, err)
+		return bz
+	}
+
+	tests := []struct {
+		name        string
+		extension   []byte
+		expectedAtt bool
+		expectedErr error
+	}{
+		{
+			name:        "attestation of the validator",
+			extension:   attestationBytes(height, 1),
+			expectedAtt: true,
+		},
+		{
+			name:      "empty extension of a restarted validator",
+			extension: nil,
+		},
+		{
+			name:        "attestation for another height",
+			extension:   attestationBytes(height+1, 1),
+			expectedErr: attestation.ErrInvalidAttestation,
+		},
+		{
+			name:        "attestation of another validator",
+			extension:   attestationBytes(height, 0),
+			expectedErr: attestation.ErrInvalidAttestation,
+		},
+		{
+			name:        "malformed extension",
+			extension:   []byte{0x01},
+			expectedErr: attestation.ErrInvalidAttestation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			att, err := attestation.VerifyExtension(tt.extension, height, address, vals.resolve)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedAtt, att != nil)
+		})
+	}
+}
+
+func TestVerifyExtendedCommit(t *testing.T) {
+	t.Parallel()
+	vals := newTestValidators(t, 4)
+	blockRoot := common.Root{0xaa}
+
+	tests := []struct {
+		name          string
+		malleate      func(ext *cmtabci.ExtendedCommitInfo, last *cmtabci.CommitInfo)
+		expectedPower int64
+		expectedErr   error
+	}{
+		{
+			name:          "all validators attest",
+			malleate:      func(*cmtabci.ExtendedCommitInfo, *cmtabci.CommitInfo) {},
+			expectedPower: 40,
+		},
+		{
+			name: "one validator voted nil",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, last *cmtabci.CommitInfo) {
+				ext.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagNil
+				ext.Votes[0].VoteExtension, ext.Votes[0].ExtensionSignature = nil, nil
+				last.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagNil
+			},
+			expectedPower: 30,
+		},
+		{
+			name: "two validators voted nil",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, last *cmtabci.CommitInfo) {
+				for i := range 2 {
+					ext.Votes[i].BlockIdFlag = cmtproto.BlockIDFlagNil
+					last.Votes[i].BlockIdFlag = cmtproto.BlockIDFlagNil
+				}
+			},
+			expectedPower: 20,
+			expectedErr:   attestation.ErrInsufficientAttestations,
+		},
+		{
+			name: "attestations for another block are not counted",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				for i := range 2 {
+					extension, err := attestation.New(height, math.ValidatorIndex(i), common.Root{0xbb}).MarshalSSZ()
+					require.NoError(t, err)
+					ext.Votes[i].VoteExtension = extension
+					ext.Votes[i].ExtensionSignature = vals.sign(t, i, extension)
+				}
+			},
+			expectedPower: 20,
+			expectedErr:   attestation.ErrInsufficientAttestations,
+		},
+		{
+			name: "empty extension of a restarted validator is not counted",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				ext.Votes[0].VoteExtension = nil
+				ext.Votes[0].ExtensionSignature = vals.sign(t, 0, nil)
+			},
+			expectedPower: 30,
+		},
+		{
+			name: "attestation of another validator is not counted",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				extension, err := attestation.New(height, 1, blockRoot).MarshalSSZ()
+				require.NoError(t, err)
+				ext.Votes[0].VoteExtension = extension
+				ext.Votes[0].ExtensionSignature = vals.sign(t, 0, extension)
+			},
+			expectedPower: 30,
+		},
+		{
+			name: "invalid extension signature",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				ext.Votes[2].ExtensionSignature = ext.Votes[1].ExtensionSignature
+			},
+			expectedErr: attestation.ErrInvalidExtendedCommit,
+		},
+		{
+			name: "vote flag differs from last commit",
+			malleate: func(_ *cmtabci.ExtendedCommitInfo, last *cmtabci.CommitInfo) {
+				last.Votes[3].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+			},
+			expectedErr: attestation.ErrInvalidExtendedCommit,
+		},
+		{
+			name: "missing vote",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				ext.Votes = ext.Votes[1:]
+			},
+			expectedErr: attestation.ErrInvalidExtendedCommit,
+		},
+		{
+			name: "round differs from last commit",
+			malleate: func(ext *cmtabci.ExtendedCommitInfo, _ *cmtabci.CommitInfo) {
+				ext.Round++
+			},
+			expectedErr: attestation.ErrInvalidExtendedCommit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			extCommit, lastCommit := vals.commits(t, blockRoot)
+			tt.malleate(extCommit, lastCommit)
+
+			power, err := attestation.VerifyExtendedCommit(
+				chainID, height, extCommit, lastCommit, blockRoot, vals.resolve,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectedPower != 0 {
+				require.Equal(t, tt.expectedPower, power)
+			}
+		})
+	}
+}
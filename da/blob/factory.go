@@ -21,13 +21,18 @@
 package blob
 
 import (
+	"fmt"
 	"time"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/merkle"
 	"golang.org/x/sync/errgroup"
@@ -35,20 +40,32 @@ import (
 
 // SidecarFactory is a factory for sidecars.
 type SidecarFactory struct {
+	// chainSpec is used to know the blobs bundle version of each fork.
+	chainSpec ChainSpec
+	// proofVerifier computes and verifies the KZG proofs of blobs bundles
+	// holding cell proofs.
+	proofVerifier kzg.BlobProofVerifier
 	// metrics is used to collect and report factory metrics.
 	metrics *factoryMetrics
 }
 
 // NewSidecarFactory creates a new sidecar factory.
 func NewSidecarFactory(
+	chainSpec ChainSpec,
+	proofVerifier kzg.BlobProofVerifier,
 	telemetrySink TelemetrySink,
 ) *SidecarFactory {
 	return &SidecarFactory{
-		metrics: newFactoryMetrics(telemetrySink),
+		chainSpec:     chainSpec,
+		proofVerifier: proofVerifier,
+		metrics:       newFactoryMetrics(telemetrySink),
 	}
 }
 
-// BuildSidecars builds a sidecar.
+// BuildSidecars builds a sidecar. The blobs bundle must hold blob proofs or
+// cell proofs depending on the fork of the block. In the latter case, the cell
+// proofs are verified and the blob proofs carried by the sidecars are computed
+// locally.
 func (f *SidecarFactory) BuildSidecars(
 	signedBlk *ctypes.SignedBeaconBlock,
 	bundle engineprimitives.BlobsBundle,
@@ -56,7 +73,6 @@ func (f *SidecarFactory) BuildSidecars(
 	var (
 		blobs       = bundle.GetBlobs()
 		commitments = bundle.GetCommitments()
-		proofs      = bundle.GetProofs()
 		numBlobs    = uint64(len(blobs))
 		sidecars    = make([]*types.BlobSidecar, numBlobs)
 		blk         = signedBlk.GetBeaconBlock()
@@ -75,6 +91,11 @@ func (f *SidecarFactory) BuildSidecars(
 	// signing root.
 	sigHeader := ctypes.NewSignedBeaconBlockHeader(header, signedBlk.GetSignature())
 
+	proofs, err := f.blobProofs(blk.GetForkVersion(), bundle)
+	if err != nil {
+		return nil, err
+	}
+
 	for i := range numBlobs {
 		g.Go(func() error {
 			inclusionProof, err := f.BuildKZGInclusionProof(body, math.U64(i))
@@ -96,6 +117,104 @@ func (f *SidecarFactory) BuildSidecars(
 	return sidecars, g.Wait()
 }
 
+// blobProofs returns the blob proofs of the bundle, after checking that its
+// version is the one expected for the given fork version.
+func (f *SidecarFactory) blobProofs(
+	forkVersion common.Version,
+	bundle engineprimitives.BlobsBundle,
+) ([]eip4844.KZGProof, error) {
+	var (
+		numBlobs   = len(bundle.GetBlobs())
+		cellProofs = f.chainSpec.BlobCellProofs(forkVersion)
+	)
+	if len(bundle.GetCommitments()) != numBlobs {
+		return nil, fmt.Errorf(
+			"%w: %d commitments for %d blobs",
+			ErrInvalidBlobsBundle, len(bundle.GetCommitments()), numBlobs,
+		)
+	}
+
+	switch b := bundle.(type) {
+	case *engineprimitives.BlobsBundleV2:
+		if !cellProofs {
+			return nil, fmt.Errorf(
+				"%w: got cell proofs for fork %s", ErrUnexpectedBlobsBundle, forkVersion,
+			)
+		}
+		return f.blobProofsFromCells(b)
+	default:
+		if cellProofs {
+			return nil, fmt.Errorf(
+				"%w: expected cell proofs for fork %s", ErrUnexpectedBlobsBundle, forkVersion,
+			)
+		}
+		if len(bundle.GetProofs()) != numBlobs {
+			return nil, fmt.Errorf(
+				"%w: %d proofs for %d blobs",
+				ErrInvalidBlobsBundle, len(bundle.GetProofs()), numBlobs,
+			)
+		}
+		return bundle.GetProofs(), nil
+	}
+}
+
+// blobProofsFromCells verifies the cell proofs of the bundle against the
+// cells of its blobs and computes the blob proofs carried by the sidecars.
+func (f *SidecarFactory) blobProofsFromCells(
+	bundle *engineprimitives.BlobsBundleV2,
+) ([]eip4844.KZGProof, error) {
+	var (
+		blobs       = bundle.GetBlobs()
+		commitments = bundle.GetCommitments()
+		numBlobs    = len(blobs)
+		numCells    = numBlobs * eip7594.CellsPerExtBlob
+	)
+	if len(bundle.GetProofs()) != numCells {
+		return nil, fmt.Errorf(
+			"%w: %d cell proofs for %d blobs",
+			ErrInvalidBlobsBundle, len(bundle.GetProofs()), numBlobs,
+		)
+	}
+	if numBlobs == 0 {
+		return []eip4844.KZGProof{}, nil
+	}
+
+	var (
+		args = &kzgtypes.CellProofArgs{
+			Commitments: make([]eip4844.KZGCommitment, numCells),
+			CellIndices: make([]uint64, numCells),
+			Cells:       make([]*eip7594.Cell, numCells),
+			Proofs:      bundle.GetProofs(),
+		}
+		proofs = make([]eip4844.KZGProof, numBlobs)
+		g      = errgroup.Group{}
+	)
+	for i := range numBlobs {
+		g.Go(func() error {
+			cells, err := f.proofVerifier.ComputeCells(blobs[i])
+			if err != nil {
+				return err
+			}
+			for j, cell := range cells {
+				k := i*eip7594.CellsPerExtBlob + j
+				args.Commitments[k] = commitments[i]
+				args.CellIndices[k] = uint64(j)
+				args.Cells[k] = cell
+			}
+			proofs[i], err = f.proofVerifier.ComputeBlobProof(blobs[i], commitments[i])
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if err := f.proofVerifier.VerifyCellProofBatch(args); err != nil {
+		return nil, err
+	}
+	return proofs, nil
+}
+
 // BuildKZGInclusionProof builds a KZG inclusion proof.
 func (f *SidecarFactory) BuildKZGInclusionProof(
 	body *ctypes.BeaconBlockBody,
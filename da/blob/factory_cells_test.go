@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/blob"
+	"github.com/berachain/beacon-kit/da/kzg/gokzg"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/utils"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+	"github.com/stretchr/testify/require"
+)
+
+var baseDir = "../../testing/files/"
+
+// cellProofsSpec enables cell proofs for Electra only.
+type cellProofsSpec struct{}
+
+func (cellProofsSpec) BlobCellProofs(forkVersion common.Version) bool {
+	return version.Equals(forkVersion, version.Electra())
+}
+
+func TestBuildSidecarsBlobsBundles(t *testing.T) {
+	t.Parallel()
+	verifier := newVerifier(t)
+	blob1, proof, commitment := loadTestData(t)
+	_, cellProofs, err := verifier.ComputeCellsAndKZGProofs((*goethkzg.Blob)(blob1), 0)
+	require.NoError(t, err)
+
+	bundleV1 := &engineprimitives.BlobsBundleV1{
+		Commitments: []eip4844.KZGCommitment{commitment},
+		Proofs:      []eip4844.KZGProof{proof},
+		Blobs:       []*eip4844.Blob{blob1},
+	}
+	newBundleV2 := func() *engineprimitives.BlobsBundleV2 {
+		b := &engineprimitives.BlobsBundleV2{
+			Commitments: []eip4844.KZGCommitment{commitment},
+			Blobs:       []*eip4844.Blob{blob1},
+		}
+		for _, p := range cellProofs {
+			b.Proofs = append(b.Proofs, eip4844.KZGProof(p))
+		}
+		return b
+	}
+
+	testCases := []struct {
+		name        string
+		forkVersion common.Version
+		bundle      func() engineprimitives.BlobsBundle
+		expectError bool
+		expectedErr error
+	}{
+		{
+			name:        "blob proofs",
+			forkVersion: version.Deneb1(),
+			bundle:      func() engineprimitives.BlobsBundle { return bundleV1 },
+		},
+		{
+			name:        "cell proofs",
+			forkVersion: version.Electra(),
+			bundle:      func() engineprimitives.BlobsBundle { return newBundleV2() },
+		},
+		{
+			name:        "blob proofs when cell proofs are expected",
+			forkVersion: version.Electra(),
+			bundle:      func() engineprimitives.BlobsBundle { return bundleV1 },
+			expectedErr: blob.ErrUnexpectedBlobsBundle,
+		},
+		{
+			name:        "cell proofs when blob proofs are expected",
+			forkVersion: version.Deneb1(),
+			bundle:      func() engineprimitives.BlobsBundle { return newBundleV2() },
+			expectedErr: blob.ErrUnexpectedBlobsBundle,
+		},
+		{
+			name:        "missing cell proofs",
+			forkVersion: version.Electra(),
+			bundle: func() engineprimitives.BlobsBundle {
+				b := newBundleV2()
+				b.Proofs = b.Proofs[1:]
+				return b
+			},
+			expectedErr: blob.ErrInvalidBlobsBundle,
+		},
+		{
+			name:        "invalid cell proof",
+			forkVersion: version.Electra(),
+			bundle: func() engineprimitives.BlobsBundle {
+				b := newBundleV2()
+				b.Proofs[0], b.Proofs[1] = b.Proofs[1], b.Proofs[0]
+				return b
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			blk := utils.GenerateValidBeaconBlock(t, tc.forkVersion)
+			blk.Body.BlobKzgCommitments = []eip4844.KZGCommitment{commitment}
+			factory := blob.NewSidecarFactory(
+				cellProofsSpec{}, verifier, metrics.NewNoOpTelemetrySink(),
+			)
+
+			sidecars, err := factory.BuildSidecars(
+				&ctypes.SignedBeaconBlock{BeaconBlock: blk}, tc.bundle(),
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sidecars, 1)
+			require.Equal(t, proof, sidecars[0].GetKzgProof())
+			require.Equal(t, commitment, sidecars[0].GetKzgCommitment())
+			require.True(t, sidecars[0].HasValidInclusionProof())
+		})
+	}
+}
+
+func newVerifier(t *testing.T) *gokzg.Verifier {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(baseDir, "kzg-trusted-setup.json"))
+	require.NoError(t, err)
+	var ts goethkzg.JSONTrustedSetup
+	require.NoError(t, json.Unmarshal(data, &ts))
+	verifier, err := gokzg.NewVerifier(&ts)
+	require.NoError(t, err)
+	return verifier
+}
+
+func loadTestData(t *testing.T) (*eip4844.Blob, eip4844.KZGProof, eip4844.KZGCommitment) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(baseDir, "test_data.json"))
+	require.NoError(t, err)
+	var test struct {
+		Input struct {
+			Blob       string `json:"blob"`
+			Commitment string `json:"commitment"`
+			Proof      string `json:"proof"`
+		} `json:"input"`
+	}
+	require.NoError(t, json.Unmarshal(data, &test))
+
+	var (
+		blob       eip4844.Blob
+		proof      eip4844.KZGProof
+		commitment eip4844.KZGCommitment
+	)
+	require.NoError(t, blob.UnmarshalJSON([]byte(`"`+test.Input.Blob+`"`)))
+	require.NoError(t, proof.UnmarshalJSON([]byte(`"`+test.Input.Proof+`"`)))
+	require.NoError(t, commitment.UnmarshalJSON([]byte(`"`+test.Input.Commitment+`"`)))
+	return &blob, proof, commitment
+}
@@ -22,8 +22,17 @@ package blob
 
 import (
 	"time"
+
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
+// ChainSpec is the subset of the chain spec used by the sidecar factory.
+type ChainSpec interface {
+	// BlobCellProofs returns whether the execution client returns cell proofs
+	// for the blobs of payloads built for the given fork version.
+	BlobCellProofs(forkVersion common.Version) bool
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
@@ -22,13 +22,17 @@ package ckzg
 
 import (
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
-	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+	ckzg4844 "github.com/ethereum/c-kzg-4844/v2/bindings/go"
 )
 
 // Implementation is the ethereum/c-kzg-4844 implementation.
 const Implementation = "ethereum/c-kzg-4844"
 
+// precompute is the precomputation level of the trusted setup. Zero disables
+// the precomputation, which is only used to speed up computing cell proofs.
+const precompute = 0
+
 // Verifier is a verifier that utilizies the CKZG library.
 type Verifier struct{}
 
@@ -40,23 +44,28 @@ func (v Verifier) GetImplementation() string {
 // NewVerifier creates a new CKZG verifier.
 //
 //nolint:mnd // lots of random numbers because cryptography.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	if err := gokzg4844.CheckTrustedSetupIsWellFormed(ts); err != nil {
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	if err := goethkzg.CheckTrustedSetupIsWellFormed(ts); err != nil {
 		return nil, err
 	}
-	g1s := make(
-		[]byte,
-		len(ts.SetupG1Lagrange)*(len(ts.SetupG1Lagrange[0])-2)/2,
-	)
-	for i, g1 := range ts.SetupG1Lagrange {
-		copy(g1s[i*(len(g1)-2)/2:], hex.MustToBytes(g1))
-	}
-	g2s := make([]byte, len(ts.SetupG2)*(len(ts.SetupG2[0])-2)/2)
-	for i, g2 := range ts.SetupG2 {
-		copy(g2s[i*(len(g2)-2)/2:], hex.MustToBytes(g2))
-	}
-	if err := ckzg4844.LoadTrustedSetup(g1s, g2s); err != nil {
+	g1Monomial := concatPoints(ts.SetupG1Monomial[:])
+	g1Lagrange := concatPoints(ts.SetupG1Lagrange[:])
+	g2Monomial := concatPoints(ts.SetupG2)
+	if err := ckzg4844.LoadTrustedSetup(
+		g1Monomial, g1Lagrange, g2Monomial, precompute,
+	); err != nil {
 		return nil, err
 	}
 	return &Verifier{}, nil
 }
+
+// concatPoints decodes the hex encoded points and concatenates their bytes.
+//
+//nolint:mnd // 0x prefix and two hex characters per byte.
+func concatPoints(points []string) []byte {
+	res := make([]byte, len(points)*(len(points[0])-2)/2)
+	for i, p := range points {
+		copy(res[i*(len(p)-2)/2:], hex.MustToBytes(p))
+	}
+	return res
+}
@@ -27,7 +27,8 @@ import (
 
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
-	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	ckzg4844 "github.com/ethereum/c-kzg-4844/v2/bindings/go"
 )
 
 // VerifyProof verifies the KZG proof that the polynomial represented by the
@@ -71,3 +72,51 @@ func (v Verifier) VerifyBlobProofBatch(
 	}
 	return nil
 }
+
+// ComputeBlobProof computes the KZG proof of the blob for the provided
+// commitment.
+func (v Verifier) ComputeBlobProof(
+	blob *eip4844.Blob,
+	commitment eip4844.KZGCommitment,
+) (eip4844.KZGProof, error) {
+	proof, err := ckzg4844.ComputeBlobKZGProof(
+		(*ckzg4844.Blob)(blob),
+		(ckzg4844.Bytes48)(commitment),
+	)
+	return eip4844.KZGProof(proof), err
+}
+
+// ComputeCells computes the cells of the blob extended with its erasure code.
+func (v Verifier) ComputeCells(blob *eip4844.Blob) ([]*eip7594.Cell, error) {
+	cells, err := ckzg4844.ComputeCells((*ckzg4844.Blob)(blob))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*eip7594.Cell, len(cells))
+	for i := range cells {
+		res[i] = (*eip7594.Cell)(&cells[i])
+	}
+	return res, nil
+}
+
+// VerifyCellProofBatch verifies the KZG proofs that the cells belong to the
+// blobs of the given commitments.
+func (v Verifier) VerifyCellProofBatch(args *types.CellProofArgs) error {
+	cells := make([]ckzg4844.Cell, len(args.Cells))
+	for i := range args.Cells {
+		cells[i] = *(*ckzg4844.Cell)(args.Cells[i])
+	}
+
+	ok, err := ckzg4844.VerifyCellKZGProofBatch(
+		*(*[]ckzg4844.Bytes48)(unsafe.Pointer(&args.Commitments)),
+		args.CellIndices,
+		cells,
+		*(*[]ckzg4844.Bytes48)(unsafe.Pointer(&args.Proofs)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ package ckzg
 import (
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 )
 
 // VerifyBlobProof will error since cgo is not enabled.
@@ -42,3 +43,21 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return ErrCGONotEnabled
 }
+
+// ComputeBlobProof will error since cgo is not enabled.
+func (v Verifier) ComputeBlobProof(
+	*eip4844.Blob,
+	eip4844.KZGCommitment,
+) (eip4844.KZGProof, error) {
+	return eip4844.KZGProof{}, ErrCGONotEnabled
+}
+
+// ComputeCells will error since cgo is not enabled.
+func (v Verifier) ComputeCells(*eip4844.Blob) ([]*eip7594.Cell, error) {
+	return nil, ErrCGONotEnabled
+}
+
+// VerifyCellProofBatch will error since cgo is not enabled.
+func (v Verifier) VerifyCellProofBatch(*types.CellProofArgs) error {
+	return ErrCGONotEnabled
+}
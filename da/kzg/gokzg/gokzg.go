@@ -25,19 +25,27 @@ import (
 
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 )
 
+// Implementation is the name of the Go implementation of KZG. The library was
+// renamed to crate-crypto/go-eth-kzg when adding EIP-7594 support, but the
+// name is kept for compatibility with existing configurations.
 const Implementation = "crate-crypto/go-kzg-4844"
 
+// numGoRoutines is the number of go routines used to compute proofs and
+// cells. Zero lets the library pick it.
+const numGoRoutines = 0
+
 // Verifier is a KZG verifier that uses the Go implementation of KZG.
 type Verifier struct {
-	*gokzg4844.Context
+	*goethkzg.Context
 }
 
 // NewVerifier creates a new GoKZGVerifier.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	ctx, err := gokzg4844.NewContext4096(ts)
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	ctx, err := goethkzg.NewContext4096(ts)
 	if err != nil {
 		return nil, err
 	}
@@ -58,9 +66,9 @@ func (v Verifier) VerifyBlobProof(
 ) error {
 	return v.Context.
 		VerifyBlobKZGProof(
-			(*gokzg4844.Blob)(blob),
-			(gokzg4844.KZGCommitment)(commitment),
-			(gokzg4844.KZGProof)(proof))
+			(*goethkzg.Blob)(blob),
+			(goethkzg.KZGCommitment)(commitment),
+			(goethkzg.KZGProof)(proof))
 }
 
 // VerifyBlobProofBatch verifies the KZG proof that the polynomial represented
@@ -69,17 +77,61 @@ func (v Verifier) VerifyBlobProof(
 func (v Verifier) VerifyBlobProofBatch(
 	args *types.BlobProofArgs,
 ) error {
-	blobs := make([]gokzg4844.Blob, len(args.Blobs))
+	blobs := make([]goethkzg.Blob, len(args.Blobs))
 	for i := range args.Blobs {
-		blobs[i] = *(*gokzg4844.Blob)(args.Blobs[i])
+		blobs[i] = *(*goethkzg.Blob)(args.Blobs[i])
 	}
 
 	//#nosec:G103 // "use of unsafe calls should be audited" lmeow.
 	return v.Context.
 		VerifyBlobKZGProofBatch(
 			blobs,
-			*(*[]gokzg4844.KZGCommitment)(
+			*(*[]goethkzg.KZGCommitment)(
 				unsafe.Pointer(&args.Commitments)),
-			*(*[]gokzg4844.KZGProof)(unsafe.Pointer(&args.Proofs)),
+			*(*[]goethkzg.KZGProof)(unsafe.Pointer(&args.Proofs)),
 		)
 }
+
+// ComputeBlobProof computes the KZG proof of the blob for the provided
+// commitment.
+func (v Verifier) ComputeBlobProof(
+	blob *eip4844.Blob,
+	commitment eip4844.KZGCommitment,
+) (eip4844.KZGProof, error) {
+	proof, err := v.Context.ComputeBlobKZGProof(
+		(*goethkzg.Blob)(blob),
+		(goethkzg.KZGCommitment)(commitment),
+		numGoRoutines,
+	)
+	return eip4844.KZGProof(proof), err
+}
+
+// ComputeCells computes the cells of the blob extended with its erasure code.
+func (v Verifier) ComputeCells(blob *eip4844.Blob) ([]*eip7594.Cell, error) {
+	cells, err := v.Context.ComputeCells((*goethkzg.Blob)(blob), numGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*eip7594.Cell, len(cells))
+	for i, cell := range cells {
+		res[i] = (*eip7594.Cell)(cell)
+	}
+	return res, nil
+}
+
+// VerifyCellProofBatch verifies the KZG proofs that the cells belong to the
+// blobs of the given commitments.
+func (v Verifier) VerifyCellProofBatch(args *types.CellProofArgs) error {
+	cells := make([]*goethkzg.Cell, len(args.Cells))
+	for i := range args.Cells {
+		cells[i] = (*goethkzg.Cell)(args.Cells[i])
+	}
+
+	//#nosec:G103 // same memory layout, see VerifyBlobProofBatch.
+	return v.Context.VerifyCellKZGProofBatch(
+		*(*[]goethkzg.KZGCommitment)(unsafe.Pointer(&args.Commitments)),
+		args.CellIndices,
+		cells,
+		*(*[]goethkzg.KZGProof)(unsafe.Pointer(&args.Proofs)),
+	)
+}
@@ -27,8 +27,9 @@ import (
 	"github.com/berachain/beacon-kit/da/kzg/gokzg"
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -129,6 +130,52 @@ func TestVerifyBlobProofBatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestComputeBlobProof(t *testing.T) {
+	t.Parallel()
+	verifier, err := setupVerifier()
+	require.NoError(t, err)
+	validBlob, validProof, validCommitment := setupTestData(
+		t, "test_data.json")
+
+	proof, err := verifier.ComputeBlobProof(validBlob, validCommitment)
+	require.NoError(t, err)
+	require.Equal(t, validProof, proof)
+}
+
+func TestVerifyCellProofBatch(t *testing.T) {
+	t.Parallel()
+	verifier, err := setupVerifier()
+	require.NoError(t, err)
+	validBlob, _, validCommitment := setupTestData(t, "test_data.json")
+
+	cells, err := verifier.ComputeCells(validBlob)
+	require.NoError(t, err)
+	require.Len(t, cells, eip7594.CellsPerExtBlob)
+
+	_, cellProofs, err := verifier.ComputeCellsAndKZGProofs(
+		(*goethkzg.Blob)(validBlob), 0)
+	require.NoError(t, err)
+
+	args := &types.CellProofArgs{
+		Commitments: make([]eip4844.KZGCommitment, len(cells)),
+		CellIndices: make([]uint64, len(cells)),
+		Cells:       cells,
+		Proofs:      make([]eip4844.KZGProof, len(cells)),
+	}
+	for i := range cells {
+		args.Commitments[i] = validCommitment
+		args.CellIndices[i] = uint64(i)
+		args.Proofs[i] = eip4844.KZGProof(cellProofs[i])
+	}
+	require.NoError(t, verifier.VerifyCellProofBatch(args))
+
+	// A cell that does not match its proof must fail the batch.
+	tampered := *cells[0]
+	tampered[0] ^= 0x01
+	args.Cells = append([]*eip7594.Cell{&tampered}, cells[1:]...)
+	require.Error(t, verifier.VerifyCellProofBatch(args))
+}
+
 func TestGetImplementation(t *testing.T) {
 	t.Parallel()
 	verifier, err := setupVerifier()
@@ -149,7 +196,7 @@ func setupVerifier() (*gokzg.Verifier, error) {
 		return nil, err
 	}
 
-	var ts gokzg4844.JSONTrustedSetup
+	var ts goethkzg.JSONTrustedSetup
 	if errUnmarshal := json.Unmarshal(file, &ts); errUnmarshal != nil {
 		return nil, errUnmarshal
 	}
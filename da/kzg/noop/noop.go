@@ -23,6 +23,7 @@ package noop
 import (
 	"github.com/berachain/beacon-kit/da/kzg/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 )
 
 // Verifier is a no-op KZG proof verifier.
@@ -53,3 +54,25 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return nil
 }
+
+// ComputeBlobProof is a no-op, it returns an empty proof.
+func (v Verifier) ComputeBlobProof(
+	*eip4844.Blob,
+	eip4844.KZGCommitment,
+) (eip4844.KZGProof, error) {
+	return eip4844.KZGProof{}, nil
+}
+
+// ComputeCells is a no-op, it returns empty cells.
+func (v Verifier) ComputeCells(*eip4844.Blob) ([]*eip7594.Cell, error) {
+	cells := make([]*eip7594.Cell, eip7594.CellsPerExtBlob)
+	for i := range cells {
+		cells[i] = &eip7594.Cell{}
+	}
+	return cells, nil
+}
+
+// VerifyCellProofBatch is a no-op.
+func (v Verifier) VerifyCellProofBatch(*types.CellProofArgs) error {
+	return nil
+}
@@ -27,7 +27,8 @@ import (
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 )
 
 // BlobProofVerifier is a verifier for blobs.
@@ -47,13 +48,25 @@ type BlobProofVerifier interface {
 	// For most implementations it is more efficient than VerifyBlobProof when
 	// verifying multiple proofs.
 	VerifyBlobProofBatch(*kzgtypes.BlobProofArgs) error
+	// ComputeBlobProof computes the KZG proof of the blob for the provided
+	// commitment.
+	ComputeBlobProof(
+		blob *eip4844.Blob,
+		commitment eip4844.KZGCommitment,
+	) (eip4844.KZGProof, error)
+	// ComputeCells computes the cells of the blob extended with its erasure
+	// code, as defined by EIP-7594.
+	ComputeCells(blob *eip4844.Blob) ([]*eip7594.Cell, error)
+	// VerifyCellProofBatch verifies the KZG proofs that the cells belong to
+	// the blobs of the given commitments.
+	VerifyCellProofBatch(*kzgtypes.CellProofArgs) error
 }
 
 // NewBlobProofVerifier creates a new BlobVerifier with the given
 // implementation.
 func NewBlobProofVerifier(
 	impl string,
-	ts *gokzg4844.JSONTrustedSetup,
+	ts *goethkzg.JSONTrustedSetup,
 ) (BlobProofVerifier, error) {
 	switch impl {
 	case gokzg.Implementation:
@@ -31,7 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -71,7 +71,7 @@ func TestNewBlobProofVerifier_InvalidImpl(t *testing.T) {
 }
 
 // loadTrustedSetupFromFile is the helper function.
-func loadTrustedSetupFromFile() (*gokzg4844.JSONTrustedSetup, error) {
+func loadTrustedSetupFromFile() (*goethkzg.JSONTrustedSetup, error) {
 	fileName := "kzg-trusted-setup.json"
 	fullPath := filepath.Join(baseDir, fileName)
 	data, err := os.ReadFile(fullPath)
@@ -79,7 +79,7 @@ func loadTrustedSetupFromFile() (*gokzg4844.JSONTrustedSetup, error) {
 		return nil, err
 	}
 
-	var ts gokzg4844.JSONTrustedSetup
+	var ts goethkzg.JSONTrustedSetup
 	err = json.Unmarshal(data, &ts)
 	if err != nil {
 		return nil, err
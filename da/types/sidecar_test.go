@@ -149,7 +149,7 @@ func TestHasValidInclusionProof(t *testing.T) {
 				t.Helper()
 				block := utils.GenerateValidBeaconBlock(t, version.Electra())
 
-				sidecarFactory := blob.NewSidecarFactory(sink)
+				sidecarFactory := blob.NewSidecarFactory(nil, nil, sink)
 				numBlobs := len(block.GetBody().GetBlobKzgCommitments())
 				sidecars := make(types.BlobSidecars, numBlobs)
 				for i := range numBlobs {
@@ -20,10 +20,16 @@
 
 package engineprimitives
 
-import "github.com/berachain/beacon-kit/primitives/eip4844"
+import (
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
+)
 
-// Compile-time assertion to ensure BlobsBundleV1 implements BlobsBundle.
-var _ BlobsBundle = (*BlobsBundleV1)(nil)
+// Compile-time assertions to ensure the bundles implement BlobsBundle.
+var (
+	_ BlobsBundle = (*BlobsBundleV1)(nil)
+	_ BlobsBundle = (*BlobsBundleV2)(nil)
+)
 
 // BlobsBundle is an interface for the blobs bundle.
 //
@@ -31,7 +37,8 @@ var _ BlobsBundle = (*BlobsBundleV1)(nil)
 type BlobsBundle interface {
 	// GetCommitments returns the commitments in the blobs bundle.
 	GetCommitments() []eip4844.KZGCommitment
-	// GetProofs returns the proofs in the blobs bundle.
+	// GetProofs returns the proofs in the blobs bundle. Depending on the
+	// bundle version, these are blob proofs or cell proofs.
 	GetProofs() []eip4844.KZGProof
 	// GetBlobs returns the blobs in the blobs bundle.
 	GetBlobs() []*eip4844.Blob
@@ -63,3 +70,41 @@ func (b *BlobsBundleV1) GetProofs() []eip4844.KZGProof {
 func (b *BlobsBundleV1) GetBlobs() []*eip4844.Blob {
 	return b.Blobs
 }
+
+// BlobsBundleV2 represents a collection of commitments, cell proofs, and blobs,
+// as returned by engine_getPayloadV5. It holds the proofs of every cell of the
+// extended blobs instead of one proof per blob.
+type BlobsBundleV2 struct {
+	// Commitments are the KZG commitments included in the bundle.
+	Commitments []eip4844.KZGCommitment `json:"commitments"`
+	// Proofs are the KZG proofs of the cells of each blob, CellsPerExtBlob
+	// proofs per blob in blob order.
+	Proofs []eip4844.KZGProof `json:"proofs"`
+	// Blobs are arbitrary data blobs included in the bundle.
+	Blobs []*eip4844.Blob `json:"blobs"`
+}
+
+// GetCommitments returns the slice of commitments in the bundle.
+func (b *BlobsBundleV2) GetCommitments() []eip4844.KZGCommitment {
+	return b.Commitments
+}
+
+// GetProofs returns the slice of cell proofs in the bundle.
+func (b *BlobsBundleV2) GetProofs() []eip4844.KZGProof {
+	return b.Proofs
+}
+
+// GetBlobs returns the slice of data blobs in the bundle.
+func (b *BlobsBundleV2) GetBlobs() []*eip4844.Blob {
+	return b.Blobs
+}
+
+// GetCellProofs returns the cell proofs of the blob at the given index, or
+// nil if the bundle does not hold them.
+func (b *BlobsBundleV2) GetCellProofs(index int) []eip4844.KZGProof {
+	start, end := index*eip7594.CellsPerExtBlob, (index+1)*eip7594.CellsPerExtBlob
+	if index < 0 || end > len(b.Proofs) {
+		return nil
+	}
+	return b.Proofs[start:end]
+}
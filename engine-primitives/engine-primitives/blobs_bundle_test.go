@@ -25,6 +25,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/eip7594"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +46,25 @@ func TestBlobsBundleV1(t *testing.T) {
 	blobs := bundle.GetBlobs()
 	require.Equal(t, bundle.Blobs, blobs)
 }
+
+func TestBlobsBundleV2(t *testing.T) {
+	t.Parallel()
+	proofs := make([]eip4844.KZGProof, 2*eip7594.CellsPerExtBlob)
+	for i := range proofs {
+		proofs[i] = eip4844.KZGProof{byte(i)}
+	}
+	bundle := &engineprimitives.BlobsBundleV2{
+		Commitments: []eip4844.KZGCommitment{{1, 2, 3}, {4, 5, 6}},
+		Proofs:      proofs,
+		Blobs:       []*eip4844.Blob{{13, 14, 15}, {16, 17, 18}},
+	}
+
+	require.Equal(t, bundle.Commitments, bundle.GetCommitments())
+	require.Equal(t, bundle.Proofs, bundle.GetProofs())
+	require.Equal(t, bundle.Blobs, bundle.GetBlobs())
+
+	require.Equal(t, proofs[:eip7594.CellsPerExtBlob], bundle.GetCellProofs(0))
+	require.Equal(t, proofs[eip7594.CellsPerExtBlob:], bundle.GetCellProofs(1))
+	require.Nil(t, bundle.GetCellProofs(2))
+	require.Nil(t, bundle.GetCellProofs(-1))
+}
@@ -64,12 +64,36 @@ func New(
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient {
-	ethClient := ethclientrpc.NewClient(
+	var ethClient ethclientrpc.Client = ethclientrpc.NewClient(
 		cfg.RPCDialURL.String(),
 		jwtSecret,
 		cfg.RPCJWTRefreshInterval,
 	)
+	if cfg.RPCRecordFile != "" {
+		recorder, err := ethclientrpc.NewRecorder(
+			ethClient, cfg.RPCRecordFile, ethclientrpc.DefaultRecordMaxSize,
+		)
+		if err != nil {
+			logger.Error("Failed to open engine API record file, not recording",
+				"path", cfg.RPCRecordFile, log.KeyError, err,
+			)
+		} else {
+			logger.Info("Recording engine API traffic", "path", cfg.RPCRecordFile)
+			ethClient = recorder
+		}
+	}
+	return NewFromRPCClient(cfg, logger, ethClient, telemetrySink, eth1ChainID)
+}
 
+// NewFromRPCClient creates a new engine client EngineClient on top of the
+// given RPC client, e.g. a replayer serving recorded responses.
+func NewFromRPCClient(
+	cfg *Config,
+	logger log.Logger,
+	ethClient ethclientrpc.Client,
+	telemetrySink TelemetrySink,
+	eth1ChainID *big.Int,
+) *EngineClient {
 	// Enforcing minimum rpc timeout
 	// The reason we do it is that we previously suggested a
 	// 900 ms default, which is unnecessarily strict.
@@ -149,7 +173,7 @@ func (s *EngineClient) Start(ctx context.Context) error {
 }
 
 func (s *EngineClient) Stop() error {
-	return nil
+	return s.Client.Close()
 }
 
 func (s *EngineClient) IsConnected() bool {
@@ -222,7 +246,7 @@ func (s *EngineClient) verifyChainIDAndConnection(
 
 	// Exchange capabilities with the execution client.
 	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
+		s.logger.Error("failed to exchange capabilities", log.KeyError, err)
 		return err
 	}
 	return nil
@@ -71,4 +71,6 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// RPCRecordFile is the file Engine API traffic is recorded to, if set.
+	RPCRecordFile string `mapstructure:"rpc-record-file"`
 }
@@ -29,6 +29,7 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
@@ -65,7 +66,7 @@ func (s *EngineClient) NewPayload(
 	if validationErr := result.ValidationError; validationErr != nil {
 		s.logger.Error(
 			"Got a validation error in newPayload",
-			"err",
+			log.KeyError,
 			errors.New(*validationErr),
 		)
 	}
@@ -128,6 +129,7 @@ func (s *EngineClient) GetPayload(
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
+	cellProofs bool,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
 	var (
 		startTime    = time.Now()
@@ -137,7 +139,7 @@ func (s *EngineClient) GetPayload(
 	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion, cellProofs)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
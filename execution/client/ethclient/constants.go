@@ -29,6 +29,7 @@ func BeaconKitSupportedCapabilities() []string {
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
 		GetPayloadMethodV4,
+		GetPayloadMethodV5,
 		GetClientVersionV1,
 	}
 }
@@ -45,6 +46,8 @@ const (
 	GetPayloadMethodV3 = "engine_getPayloadV3"
 	// GetPayloadMethodV4 for retrieving a payload in Electra.
 	GetPayloadMethodV4 = "engine_getPayloadV4"
+	// GetPayloadMethodV5 for retrieving a payload with blob cell proofs.
+	GetPayloadMethodV5 = "engine_getPayloadV5"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
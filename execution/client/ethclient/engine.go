@@ -152,15 +152,21 @@ func (s *Client) ForkchoiceUpdatedV3(
 /* -------------------------------------------------------------------------- */
 
 // GetPayload calls the appropriate version of the Engine API GetPayload method.
+// If cellProofs is set, the payload is retrieved with the blobs bundle holding
+// EIP-7594 cell proofs.
 func (s *Client) GetPayload(
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
+	cellProofs bool,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
 	// Versions before Deneb are not supported for calling GetPayload.
 	if version.IsBefore(forkVersion, version.Deneb()) {
 		return nil, ErrInvalidVersion
 	}
+	if cellProofs {
+		return s.GetPayloadV5(ctx, payloadID, forkVersion)
+	}
 	if version.Equals(forkVersion, version.Deneb()) || version.Equals(forkVersion, version.Deneb1()) {
 		return s.GetPayloadV3(ctx, payloadID, forkVersion)
 	}
@@ -196,6 +202,20 @@ func (s *Client) GetPayloadV4(
 	return result, nil
 }
 
+// GetPayloadV5 calls the engine_getPayloadV5 method via JSON-RPC. The blobs
+// bundle of the result holds cell proofs.
+func (s *Client) GetPayloadV5(
+	ctx context.Context,
+	payloadID engineprimitives.PayloadID,
+	forkVersion common.Version,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	result := ctypes.NewEmptyExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV2](forkVersion)
+	if err := s.Call(ctx, result, GetPayloadMethodV5, payloadID); err != nil {
+		return nil, fmt.Errorf("failed GetPayloadV5 call: %w", err)
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
@@ -131,7 +131,7 @@ func TestGetPayloadWithValidVersion(t *testing.T) {
 	var payloadID engineprimitives.PayloadID
 	forkVersion := version.Deneb1()
 
-	_, err := c.GetPayload(ctx, payloadID, forkVersion)
+	_, err := c.GetPayload(ctx, payloadID, forkVersion, false)
 	require.NoError(t, err)
 }
 
@@ -144,7 +144,7 @@ func TestGetPayloadWithInvalidVersion(t *testing.T) {
 	var payloadID engineprimitives.PayloadID
 	forkVersion := version.Capella()
 
-	_, err := c.GetPayload(ctx, payloadID, forkVersion)
+	_, err := c.GetPayload(ctx, payloadID, forkVersion, false)
 	require.ErrorIs(t, err, ethclient.ErrInvalidVersion)
 }
 
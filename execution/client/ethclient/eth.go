@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/geth-primitives/rpc"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +45,49 @@ func (s *Client) ChainID(
 	return result, nil
 }
 
+// SyncProgress is the progress of a syncing execution client, as returned
+// by eth_syncing.
+type SyncProgress struct {
+	// StartingBlock is the block the current sync started at.
+	StartingBlock math.U64 `json:"startingBlock"`
+	// CurrentBlock is the block the client is currently at.
+	CurrentBlock math.U64 `json:"currentBlock"`
+	// HighestBlock is the highest block known to the client.
+	HighestBlock math.U64 `json:"highestBlock"`
+}
+
+// Syncing returns the sync progress of the execution client, or nil if it
+// is not syncing.
+func (s *Client) Syncing(
+	ctx context.Context,
+) (*SyncProgress, error) {
+	var raw json.RawMessage
+	if err := s.Call(ctx, &raw, "eth_syncing"); err != nil {
+		return nil, err
+	}
+	// eth_syncing returns false when the client is not syncing.
+	var syncing bool
+	if err := json.Unmarshal(raw, &syncing); err == nil {
+		return nil, nil //nolint:nilnil // not syncing.
+	}
+	progress := new(SyncProgress)
+	if err := json.Unmarshal(raw, progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// BlockNumber returns the number of the head block of the execution client.
+func (s *Client) BlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	var result math.U64
+	if err := s.Call(ctx, &result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
@@ -29,9 +29,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	beaconhttp "github.com/berachain/beacon-kit/primitives/net/http"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var _ Client = (*client)(nil)
@@ -123,7 +125,12 @@ func (rpc *client) Call(
 	method string,
 	params ...any,
 ) error {
+	ctx, span := tracing.StartClient(ctx, method,
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("rpc.method", method),
+	)
 	result, err := rpc.callRaw(ctx, method, params...)
+	tracing.End(span, err)
 	if err != nil {
 		return err
 	}
@@ -169,6 +176,7 @@ func (rpc *client) callRaw(
 	rpc.mu.RLock()
 	req.Header = rpc.header.Clone()
 	rpc.mu.RUnlock()
+	tracing.Inject(ctx, req.Header)
 
 	response, err := rpc.client.Do(req)
 	if err != nil {
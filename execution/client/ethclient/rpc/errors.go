@@ -22,4 +22,14 @@ package rpc
 
 import "errors"
 
-var ErrNilResponse = errors.New("nil response")
+var (
+	ErrNilResponse = errors.New("nil response")
+
+	// ErrReplayExhausted is returned when a replayer has served all of its
+	// records.
+	ErrReplayExhausted = errors.New("no recorded responses left")
+
+	// ErrReplayMismatch is returned when a call does not match the next
+	// recorded one.
+	ErrReplayMismatch = errors.New("call does not match recorded method")
+)
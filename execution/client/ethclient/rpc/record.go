@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	beaconhttp "github.com/berachain/beacon-kit/primitives/net/http"
+)
+
+// maxRecordLineSize bounds a single recorded line, large enough for a
+// GetPayload response carrying a full blobs bundle.
+const maxRecordLineSize = 64 << 20
+
+// Record is a single recorded Engine API exchange.
+type Record struct {
+	// Time is when the request was sent.
+	Time time.Time `json:"time"`
+	// Method is the JSON-RPC method that was called.
+	Method string `json:"method"`
+	// Params are the JSON encoded request parameters.
+	Params json.RawMessage `json:"params"`
+	// Result is the raw JSON-RPC result, empty if the call failed.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Latency is the time the call took to complete.
+	Latency time.Duration `json:"latency"`
+	// RPCError is the JSON-RPC error returned by the execution client.
+	RPCError *Error `json:"rpcError,omitempty"`
+	// Failure is the message of any other error the call returned,
+	// e.g. a transport error or a timeout.
+	Failure string `json:"failure,omitempty"`
+}
+
+// newRecord builds the record of a call from its outcome.
+func newRecord(
+	start time.Time,
+	method string,
+	params []any,
+	result json.RawMessage,
+	err error,
+) (*Record, error) {
+	bz, mErr := json.Marshal(params)
+	if mErr != nil {
+		return nil, mErr
+	}
+	r := &Record{
+		Time:    start,
+		Method:  method,
+		Params:  bz,
+		Result:  result,
+		Latency: time.Since(start),
+	}
+	if err != nil {
+		r.Result = nil
+		var rpcErr Error
+		if errors.As(err, &rpcErr) {
+			r.RPCError = &rpcErr
+		} else {
+			r.Failure = err.Error()
+		}
+	}
+	return r, nil
+}
+
+// Err returns the error the recorded call returned, if any. Transport
+// failures that the engine client distinguishes are mapped back to their
+// sentinel values, any other failure is returned as an opaque error.
+func (r *Record) Err() error {
+	switch {
+	case r.RPCError != nil:
+		return *r.RPCError
+	case r.Failure == "":
+		return nil
+	case r.Failure == context.DeadlineExceeded.Error():
+		return context.DeadlineExceeded
+	case r.Failure == beaconhttp.ErrUnauthorized.Error():
+		return beaconhttp.ErrUnauthorized
+	default:
+		return errors.New(r.Failure)
+	}
+}
+
+// ReadRecords reads the records written by a recorder to the given file.
+func ReadRecords(path string) ([]*Record, error) {
+	//#nosec:G304 // path is provided by the operator.
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxRecordLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		r := new(Record)
+		if err = json.Unmarshal(scanner.Bytes(), r); err != nil {
+			return nil, errors.Wrapf(err, "invalid record %d", len(records))
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	// DefaultRecordMaxSize is the size after which a record file is rotated.
+	DefaultRecordMaxSize = 100 << 20
+	// recordMaxBackups is the number of rotated record files kept.
+	recordMaxBackups = 5
+)
+
+var _ Client = (*Recorder)(nil)
+
+// Recorder is a Client that writes every call made through the wrapped
+// client, together with its response, to a JSONL file. The file is rotated
+// once it grows past the configured size, keeping the most recent backups
+// as <path>.1 (newest) through <path>.5 (oldest).
+type Recorder struct {
+	Client
+	// path is the file records are appended to.
+	path string
+	// maxSize is the size in bytes after which the file is rotated.
+	maxSize int64
+
+	// mu protects the file and its size.
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewRecorder wraps the given client so that its calls are recorded to path.
+func NewRecorder(client Client, path string, maxSize int64) (*Recorder, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultRecordMaxSize
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	r := &Recorder{
+		Client:  client,
+		path:    path,
+		maxSize: maxSize,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Call calls the given method on the wrapped client and records the
+// exchange. Failing to record never fails the call itself.
+func (r *Recorder) Call(
+	ctx context.Context,
+	target any,
+	method string,
+	params ...any,
+) error {
+	var (
+		result json.RawMessage
+		start  = time.Now()
+		err    = r.Client.Call(ctx, &result, method, params...)
+	)
+	if record, rErr := newRecord(start, method, params, result, err); rErr == nil {
+		//#nosec:G104 // recording is best effort.
+		_ = r.write(record)
+	}
+
+	if err != nil || target == nil {
+		return err
+	}
+	return json.Unmarshal(result, target)
+}
+
+// Close closes the wrapped client and the record file.
+func (r *Recorder) Close() error {
+	err := r.Client.Close()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if fErr := r.file.Close(); err == nil {
+		err = fErr
+	}
+	return err
+}
+
+// write appends the record to the file, rotating it first if needed.
+func (r *Recorder) write(record *Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.size > 0 && r.size+int64(len(bz)) > r.maxSize {
+		if err = r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(bz)
+	r.size += int64(n)
+	return err
+}
+
+// open opens the record file for appending.
+func (r *Recorder) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	r.file, r.size = f, info.Size()
+	return nil
+}
+
+// rotate shifts the existing backups, moves the current file to <path>.1
+// and starts a new one.
+func (r *Recorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	for i := recordMaxBackups - 1; i > 0; i-- {
+		//#nosec:G104 // missing backups are expected.
+		_ = os.Rename(backupPath(r.path, i), backupPath(r.path, i+1))
+	}
+	if err := os.Rename(r.path, backupPath(r.path, 1)); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// backupPath returns the path of the i-th rotated record file.
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
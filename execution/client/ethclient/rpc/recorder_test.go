@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedClient answers calls with a fixed result per method, or with the
+// given error.
+type scriptedClient struct {
+	results map[string]any
+	errs    map[string]error
+}
+
+func (*scriptedClient) Start(context.Context) {}
+func (*scriptedClient) Close() error          { return nil }
+func (c *scriptedClient) Call(_ context.Context, target any, method string, _ ...any) error {
+	if err := c.errs[method]; err != nil {
+		return err
+	}
+	bz, err := json.Marshal(c.results[method])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, target)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+	rpcErr := rpc.Error{Code: -38001, Message: "unknown payload"}
+
+	rec, err := rpc.NewRecorder(&scriptedClient{
+		results: map[string]any{"eth_chainId": "0x138d5"},
+		errs: map[string]error{
+			"engine_getPayloadV3": rpcErr,
+			"engine_newPayloadV3": context.DeadlineExceeded,
+		},
+	}, path, 0)
+	require.NoError(t, err)
+
+	var chainID string
+	require.NoError(t, rec.Call(ctx, &chainID, "eth_chainId"))
+	require.Equal(t, "0x138d5", chainID)
+	require.ErrorIs(t, rec.Call(ctx, nil, "engine_getPayloadV3", "0x01"), rpcErr)
+	require.ErrorIs(t, rec.Call(ctx, nil, "engine_newPayloadV3"), context.DeadlineExceeded)
+	require.NoError(t, rec.Close())
+
+	records, err := rpc.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "engine_getPayloadV3", records[1].Method)
+	require.JSONEq(t, `["0x01"]`, string(records[1].Params))
+
+	replayer := rpc.NewReplayer(records)
+	chainID = ""
+	require.NoError(t, replayer.Call(ctx, &chainID, "eth_chainId"))
+	require.Equal(t, "0x138d5", chainID)
+	require.ErrorIs(t, replayer.Call(ctx, nil, "engine_forkchoiceUpdatedV3"), rpc.ErrReplayMismatch)
+	require.ErrorIs(t, replayer.Call(ctx, nil, "engine_getPayloadV3"), rpcErr)
+	require.ErrorIs(t, replayer.Call(ctx, nil, "engine_newPayloadV3"), context.DeadlineExceeded)
+	require.Zero(t, replayer.Remaining())
+	require.ErrorIs(t, replayer.Call(ctx, nil, "eth_chainId"), rpc.ErrReplayExhausted)
+}
+
+func TestRecorderRotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+
+	rec, err := rpc.NewRecorder(&scriptedClient{
+		results: map[string]any{"eth_chainId": "0x138d5"},
+	}, path, 1)
+	require.NoError(t, err)
+	for range 10 {
+		require.NoError(t, rec.Call(ctx, nil, "eth_chainId"))
+	}
+	require.NoError(t, rec.Close())
+
+	// Every record exceeds the limit, so each file holds a single one and
+	// only the configured number of backups is kept.
+	records, err := rpc.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	for i := 1; i <= 5; i++ {
+		records, err = rpc.ReadRecords(path + "." + strconv.Itoa(i))
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	}
+	_, err = os.Stat(path + ".6")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+var _ Client = (*Replayer)(nil)
+
+// Replayer is a Client that serves previously recorded responses instead of
+// talking to an execution client. Calls must be made in the order they were
+// recorded, which makes a recorded sequence of Engine API calls
+// reproducible in tests.
+type Replayer struct {
+	// mu protects next.
+	mu sync.Mutex
+	// records are the recorded exchanges, in call order.
+	records []*Record
+	// next is the index of the record served by the next call.
+	next int
+}
+
+// NewReplayer creates a replayer serving the given records.
+func NewReplayer(records []*Record) *Replayer {
+	return &Replayer{records: records}
+}
+
+// NewReplayerFromFile creates a replayer serving the records in the given
+// file.
+func NewReplayerFromFile(path string) (*Replayer, error) {
+	records, err := ReadRecords(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(records), nil
+}
+
+// Start is a no-op, there is no connection to maintain.
+func (*Replayer) Start(context.Context) {}
+
+// Close is a no-op.
+func (*Replayer) Close() error { return nil }
+
+// Call serves the next recorded response. It fails if all records have
+// been served or if the next record is for a different method.
+func (r *Replayer) Call(
+	ctx context.Context,
+	target any,
+	method string,
+	_ ...any,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	if r.next >= len(r.records) {
+		r.mu.Unlock()
+		return errors.Wrapf(ErrReplayExhausted, "calling %s", method)
+	}
+	record := r.records[r.next]
+	if record.Method != method {
+		r.mu.Unlock()
+		return errors.Wrapf(
+			ErrReplayMismatch,
+			"record %d: expected %s, got %s", r.next, record.Method, method,
+		)
+	}
+	r.next++
+	r.mu.Unlock()
+
+	if err := record.Err(); err != nil {
+		return err
+	}
+	if target == nil || len(record.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(record.Result, target)
+}
+
+// Remaining returns the number of records not served yet.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.records) - r.next
+}
@@ -0,0 +1,345 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginemock
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// gasLimit is the gas limit of every block built by the mock engine.
+	gasLimit = 30_000_000
+	// baseFeePerGas is the base fee of every block built by the mock engine.
+	baseFeePerGas = 7
+)
+
+// block is a block of the fake chain.
+type block struct {
+	hash       common.ExecutionHash
+	parentHash common.ExecutionHash
+	number     uint64
+	timestamp  uint64
+	stateRoot  common.Bytes32
+}
+
+// depositLog is a deposit emitted in a block of the fake chain.
+type depositLog struct {
+	blockNumber uint64
+	blockHash   common.ExecutionHash
+	deposit     *ctypes.Deposit
+}
+
+// chain is the deterministic fake state of the mock engine. Blocks carry no
+// transactions and their state root is derived from the parent one, so that
+// the same sequence of Engine API calls always yields the same block hashes.
+type chain struct {
+	mu sync.RWMutex
+
+	blocks    map[common.ExecutionHash]*block
+	head      *block
+	safe      common.ExecutionHash
+	finalized common.ExecutionHash
+
+	// builds holds the payload attributes of the payloads being built.
+	builds map[engineprimitives.PayloadID]*payloadBuild
+
+	// pendingDeposits are emitted in the next block inserted in the chain.
+	pendingDeposits []*ctypes.Deposit
+	deposits        []depositLog
+}
+
+// payloadBuild is a payload build process started by forkchoiceUpdated.
+type payloadBuild struct {
+	parent *block
+	attrs  *engineprimitives.PayloadAttributes
+}
+
+// newChain creates a fake chain starting from the given genesis block.
+func newChain(genesis *gethprimitives.Block) *chain {
+	g := &block{
+		hash:       common.ExecutionHash(genesis.Hash()),
+		parentHash: common.ExecutionHash(genesis.ParentHash()),
+		number:     genesis.NumberU64(),
+		timestamp:  genesis.Time(),
+		stateRoot:  common.Bytes32(genesis.Root()),
+	}
+	return &chain{
+		blocks:    map[common.ExecutionHash]*block{g.hash: g},
+		head:      g,
+		safe:      g.hash,
+		finalized: g.hash,
+		builds:    make(map[engineprimitives.PayloadID]*payloadBuild),
+	}
+}
+
+// insertPayload validates the payload against the fake chain and inserts it.
+func (c *chain) insertPayload(
+	payload *ctypes.ExecutionPayload,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.Root,
+	executionRequests []ctypes.EncodedExecutionRequest,
+) *engineprimitives.PayloadStatusV1 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hash := payload.GetBlockHash()
+	if _, ok := c.blocks[hash]; ok {
+		return validStatus(hash)
+	}
+	parent, ok := c.blocks[payload.GetParentHash()]
+	if !ok {
+		return &engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusSyncing,
+		}
+	}
+
+	var (
+		ethBlock   *gethprimitives.Block
+		blobHashes []gethprimitives.ExecutionHash
+		err        error
+	)
+	if version.IsBefore(payload.GetForkVersion(), version.Electra()) {
+		ethBlock, blobHashes, err = ctypes.MakeEthBlock(payload, parentBeaconBlockRoot)
+	} else {
+		ethBlock, blobHashes, err = ctypes.MakeEthBlockWithExecutionRequests(
+			payload, parentBeaconBlockRoot, executionRequests,
+		)
+	}
+	switch {
+	case err != nil:
+		return invalidStatus(parent.hash, err.Error())
+	case common.ExecutionHash(ethBlock.Hash()) != hash:
+		return invalidStatus(parent.hash, fmt.Sprintf(
+			"invalid block hash, expected %s, got %s", ethBlock.Hash(), hash,
+		))
+	case len(blobHashes) != len(versionedHashes):
+		return invalidStatus(parent.hash, fmt.Sprintf(
+			"invalid number of versioned hashes, expected %d, got %d",
+			len(blobHashes), len(versionedHashes),
+		))
+	case payload.GetNumber().Unwrap() != parent.number+1:
+		return invalidStatus(parent.hash, "invalid block number")
+	case payload.GetTimestamp().Unwrap() <= parent.timestamp:
+		return invalidStatus(parent.hash, "invalid timestamp")
+	}
+
+	c.blocks[hash] = &block{
+		hash:       hash,
+		parentHash: parent.hash,
+		number:     payload.GetNumber().Unwrap(),
+		timestamp:  payload.GetTimestamp().Unwrap(),
+		stateRoot:  payload.GetStateRoot(),
+	}
+	for _, d := range c.pendingDeposits {
+		c.deposits = append(c.deposits, depositLog{
+			blockNumber: payload.GetNumber().Unwrap(),
+			blockHash:   hash,
+			deposit:     d,
+		})
+	}
+	c.pendingDeposits = nil
+	return validStatus(hash)
+}
+
+// forkchoiceUpdated updates the forkchoice of the fake chain and, if attrs
+// are given, starts building a payload on top of the new head.
+func (c *chain) forkchoiceUpdated(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *engineprimitives.PayloadAttributes,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{
+				Status: engineprimitives.PayloadStatusSyncing,
+			},
+		}, nil
+	}
+	c.head = head
+	c.safe = state.SafeBlockHash
+	c.finalized = state.FinalizedBlockHash
+
+	res := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: *validStatus(head.hash),
+	}
+	if attrs == nil {
+		return res, nil
+	}
+	if attrs.Timestamp.Unwrap() <= head.timestamp {
+		return nil, engineerrors.ErrInvalidPayloadAttributes
+	}
+
+	id, err := payloadID(head.hash, attrs)
+	if err != nil {
+		return nil, err
+	}
+	c.builds[id] = &payloadBuild{parent: head, attrs: attrs}
+	res.PayloadID = &id
+	return res, nil
+}
+
+// buildPayload returns the payload built by the given build process for the
+// given fork version.
+func (c *chain) buildPayload(
+	id engineprimitives.PayloadID, forkVersion common.Version,
+) (*ctypes.ExecutionPayload, []ctypes.EncodedExecutionRequest, error) {
+	c.mu.RLock()
+	build, ok := c.builds[id]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, nil, engineerrors.ErrUnknownPayload
+	}
+
+	parent, attrs := build.parent, build.attrs
+	number := parent.number + 1
+	payload := ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion)
+	payload.ParentHash = parent.hash
+	payload.FeeRecipient = attrs.SuggestedFeeRecipient
+	payload.StateRoot = nextStateRoot(parent.stateRoot, number)
+	payload.ReceiptsRoot = common.Bytes32(types.EmptyReceiptsHash)
+	payload.Random = attrs.PrevRandao
+	payload.Number = math.U64(number)
+	payload.GasLimit = gasLimit
+	payload.Timestamp = attrs.Timestamp
+	payload.ExtraData = []byte{}
+	payload.BaseFeePerGas = math.NewU256(baseFeePerGas)
+	payload.Transactions = [][]byte{}
+	if attrs.Withdrawals != nil {
+		payload.Withdrawals = attrs.Withdrawals
+	}
+
+	var (
+		parentBeaconBlockRoot = attrs.ParentBeaconBlockRoot
+		executionRequests     []ctypes.EncodedExecutionRequest
+		ethBlock              *gethprimitives.Block
+		err                   error
+	)
+	if version.IsBefore(forkVersion, version.Electra()) {
+		ethBlock, _, err = ctypes.MakeEthBlock(payload, &parentBeaconBlockRoot)
+	} else {
+		executionRequests = []ctypes.EncodedExecutionRequest{}
+		ethBlock, _, err = ctypes.MakeEthBlockWithExecutionRequests(
+			payload, &parentBeaconBlockRoot, executionRequests,
+		)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	payload.BlockHash = common.ExecutionHash(ethBlock.Hash())
+	return payload, executionRequests, nil
+}
+
+// addDeposit queues a deposit to be emitted in the next inserted block. The
+// deposit index is assigned in order of emission.
+func (c *chain) addDeposit(d *ctypes.Deposit) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d.Index = uint64(len(c.deposits) + len(c.pendingDeposits))
+	c.pendingDeposits = append(c.pendingDeposits, d)
+}
+
+// depositLogs returns the deposits emitted in the blocks in [from, to].
+func (c *chain) depositLogs(from, to uint64) []depositLog {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	logs := make([]depositLog, 0)
+	for _, l := range c.deposits {
+		if l.blockNumber >= from && l.blockNumber <= to {
+			logs = append(logs, l)
+		}
+	}
+	return logs
+}
+
+// headNumber returns the number of the head block.
+func (c *chain) headNumber() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.head.number
+}
+
+// forkchoice returns the current forkchoice of the fake chain.
+func (c *chain) forkchoice() *engineprimitives.ForkchoiceStateV1 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      c.head.hash,
+		SafeBlockHash:      c.safe,
+		FinalizedBlockHash: c.finalized,
+	}
+}
+
+// payloadID derives a payload ID from the parent block and the attributes,
+// so that identical build requests are identified by the same ID.
+func payloadID(
+	parent common.ExecutionHash, attrs *engineprimitives.PayloadAttributes,
+) (engineprimitives.PayloadID, error) {
+	bz, err := json.Marshal(attrs)
+	if err != nil {
+		return engineprimitives.PayloadID{}, err
+	}
+	h := sha256.New()
+	h.Write(parent[:])
+	h.Write(bz)
+	return engineprimitives.PayloadID(h.Sum(nil)[:8]), nil
+}
+
+// nextStateRoot derives the fake state root of a block from the state root of
+// its parent.
+func nextStateRoot(parent common.Bytes32, number uint64) common.Bytes32 {
+	h := sha256.New()
+	h.Write(parent[:])
+	h.Write(binary.BigEndian.AppendUint64(nil, number))
+	return common.Bytes32(h.Sum(nil))
+}
+
+func validStatus(hash common.ExecutionHash) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &hash,
+	}
+}
+
+func invalidStatus(
+	latestValidHash common.ExecutionHash, validationErr string,
+) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusInvalid,
+		LatestValidHash: &latestValidHash,
+		ValidationError: &validationErr,
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginemock
+
+import (
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+)
+
+// Config is the configuration of the mock engine.
+type Config struct {
+	// Genesis is the execution genesis the fake chain starts from. The
+	// chain ID of the mock engine is the one of the genesis.
+	Genesis *gethprimitives.Genesis
+	// DepositContract is the address the deposit logs are emitted from.
+	DepositContract common.ExecutionAddress
+	// JWTSecret, if set, is required to authenticate the Engine API calls.
+	JWTSecret *jwt.Secret
+	// Faults are the faults injected from the start.
+	Faults Faults
+}
+
+// Faults are the faults the mock engine injects in its responses.
+type Faults struct {
+	// Status, if set, is returned by newPayload and forkchoiceUpdated instead
+	// of the actual payload status. It must be one of SYNCING, ACCEPTED or
+	// INVALID.
+	Status string
+	// Latency is added to the response time of every call.
+	Latency time.Duration
+}
+
+// validate checks the faults can be injected.
+func (f Faults) validate() error {
+	switch f.Status {
+	case "",
+		engineprimitives.PayloadStatusSyncing,
+		engineprimitives.PayloadStatusAccepted,
+		engineprimitives.PayloadStatusInvalid:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidStatus, "%q", f.Status)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginemock
+
+import "github.com/berachain/beacon-kit/errors"
+
+// JSON-RPC error codes returned by the mock engine, as per the Engine API
+// specification.
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+	codeUnknownPayload = -38001
+	codeInvalidAttrs   = -38003
+)
+
+var (
+	// ErrNilGenesis is returned when the mock engine is configured without an
+	// execution genesis.
+	ErrNilGenesis = errors.New("execution genesis must not be nil")
+
+	// ErrNilChainID is returned when the execution genesis has no chain ID.
+	ErrNilChainID = errors.New("execution genesis has no chain ID")
+
+	// ErrInvalidStatus is returned when a fault is configured with a payload
+	// status the mock engine cannot return.
+	ErrInvalidStatus = errors.New("invalid payload status")
+
+	// errMethodNotFound is returned when calling a method the mock engine does
+	// not serve.
+	errMethodNotFound = errors.New("method not found")
+
+	// errInvalidParams is returned when the params of a call cannot be
+	// decoded.
+	errInvalidParams = errors.New("invalid params")
+
+	// ErrAlreadyStarted is returned when starting a mock engine twice.
+	ErrAlreadyStarted = errors.New("mock engine already started")
+)
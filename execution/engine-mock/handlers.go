@@ -0,0 +1,340 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginemock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strings"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// clientCode is the client code reported by engine_getClientVersionV1.
+const clientCode = "MK"
+
+// registerMethods returns the JSON-RPC methods served by the mock engine.
+func (s *Server) registerMethods() map[string]method {
+	return map[string]method{
+		ethclient.NewPayloadMethodV3: func(ctx context.Context, p []json.RawMessage) (any, error) {
+			return s.newPayload(ctx, version.Deneb(), p)
+		},
+		ethclient.NewPayloadMethodV4: func(ctx context.Context, p []json.RawMessage) (any, error) {
+			return s.newPayload(ctx, version.Electra(), p)
+		},
+		ethclient.ForkchoiceUpdatedMethodV3: s.forkchoiceUpdated,
+		ethclient.GetPayloadMethodV3: func(ctx context.Context, p []json.RawMessage) (any, error) {
+			return s.getPayload(ctx, version.Deneb(), false, p)
+		},
+		ethclient.GetPayloadMethodV4: func(ctx context.Context, p []json.RawMessage) (any, error) {
+			return s.getPayload(ctx, version.Electra(), false, p)
+		},
+		ethclient.GetPayloadMethodV5: func(ctx context.Context, p []json.RawMessage) (any, error) {
+			return s.getPayload(ctx, version.Electra(), true, p)
+		},
+		ethclient.ExchangeCapabilities: s.exchangeCapabilities,
+		ethclient.GetClientVersionV1:   s.getClientVersion,
+		"eth_chainId":                  s.ethChainID,
+		"eth_getLogs":                  s.ethGetLogs,
+		"eth_syncing":                  s.ethSyncing,
+		"eth_blockNumber":              s.ethBlockNumber,
+	}
+}
+
+// capabilities returns the Engine API methods served by the mock engine.
+func (s *Server) capabilities() []string {
+	capabilities := make([]string, 0, len(s.methods))
+	for name := range s.methods {
+		if strings.HasPrefix(name, "engine_") {
+			capabilities = append(capabilities, name)
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Engine API                                 */
+/* -------------------------------------------------------------------------- */
+
+// newPayload serves engine_newPayloadV3 and engine_newPayloadV4.
+func (s *Server) newPayload(
+	_ context.Context, forkVersion common.Version, params []json.RawMessage,
+) (any, error) {
+	numParams := 3
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		numParams = 4
+	}
+	if err := checkNumParams(params, numParams); err != nil {
+		return nil, err
+	}
+
+	var (
+		payload               = ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion)
+		versionedHashes       []common.ExecutionHash
+		parentBeaconBlockRoot *common.Root
+		executionRequests     []ctypes.EncodedExecutionRequest
+	)
+	targets := []any{payload, &versionedHashes, &parentBeaconBlockRoot, &executionRequests}
+	if err := decodeParams(params, targets[:numParams]...); err != nil {
+		return nil, err
+	}
+	if status := s.getFaults().Status; status != "" {
+		return &engineprimitives.PayloadStatusV1{Status: status}, nil
+	}
+	return s.chain.insertPayload(
+		payload, versionedHashes, parentBeaconBlockRoot, executionRequests,
+	), nil
+}
+
+// forkchoiceUpdated serves engine_forkchoiceUpdatedV3.
+func (s *Server) forkchoiceUpdated(
+	_ context.Context, params []json.RawMessage,
+) (any, error) {
+	if err := checkNumParams(params, 2); err != nil {
+		return nil, err
+	}
+	var (
+		state engineprimitives.ForkchoiceStateV1
+		attrs *engineprimitives.PayloadAttributes
+	)
+	if err := decodeParams(params, &state, &attrs); err != nil {
+		return nil, err
+	}
+	if status := s.getFaults().Status; status != "" {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{Status: status},
+		}, nil
+	}
+	return s.chain.forkchoiceUpdated(&state, attrs)
+}
+
+// payloadEnvelope is the response of engine_getPayloadV3 and
+// engine_getPayloadV4.
+type payloadEnvelope struct {
+	ExecutionPayload  *ctypes.ExecutionPayload         `json:"executionPayload"`
+	BlockValue        *math.U256Hex                    `json:"blockValue"`
+	BlobsBundle       engineprimitives.BlobsBundle     `json:"blobsBundle"`
+	ExecutionRequests []ctypes.EncodedExecutionRequest `json:"executionRequests"`
+	Override          bool                             `json:"shouldOverrideBuilder"`
+}
+
+// getPayload serves engine_getPayloadV3, engine_getPayloadV4 and
+// engine_getPayloadV5, the latter returning a blobs bundle with cell proofs.
+func (s *Server) getPayload(
+	_ context.Context, forkVersion common.Version, cellProofs bool, params []json.RawMessage,
+) (any, error) {
+	if err := checkNumParams(params, 1); err != nil {
+		return nil, err
+	}
+	var id engineprimitives.PayloadID
+	if err := decodeParams(params, &id); err != nil {
+		return nil, err
+	}
+	payload, executionRequests, err := s.chain.buildPayload(id, forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	var blobsBundle engineprimitives.BlobsBundle = &engineprimitives.BlobsBundleV1{
+		Commitments: []eip4844.KZGCommitment{},
+		Proofs:      []eip4844.KZGProof{},
+		Blobs:       []*eip4844.Blob{},
+	}
+	if cellProofs {
+		blobsBundle = &engineprimitives.BlobsBundleV2{
+			Commitments: []eip4844.KZGCommitment{},
+			Proofs:      []eip4844.KZGProof{},
+			Blobs:       []*eip4844.Blob{},
+		}
+	}
+	return &payloadEnvelope{
+		ExecutionPayload:  payload,
+		BlockValue:        (*math.U256Hex)(math.NewU256(0)),
+		BlobsBundle:       blobsBundle,
+		ExecutionRequests: executionRequests,
+	}, nil
+}
+
+// exchangeCapabilities serves engine_exchangeCapabilities.
+func (s *Server) exchangeCapabilities(context.Context, []json.RawMessage) (any, error) {
+	return s.capabilities(), nil
+}
+
+// getClientVersion serves engine_getClientVersionV1.
+func (s *Server) getClientVersion(context.Context, []json.RawMessage) (any, error) {
+	return []engineprimitives.ClientVersionV1{{
+		Code:    clientCode,
+		Name:    "beacon-kit-mock-engine",
+		Version: "v0.0.0",
+		Commit:  "00000000",
+	}}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Eth API                                  */
+/* -------------------------------------------------------------------------- */
+
+// ethChainID serves eth_chainId.
+func (s *Server) ethChainID(context.Context, []json.RawMessage) (any, error) {
+	return math.U64(s.chainID), nil
+}
+
+// ethSyncing serves eth_syncing. The mock engine is never syncing.
+func (s *Server) ethSyncing(context.Context, []json.RawMessage) (any, error) {
+	return false, nil
+}
+
+// ethBlockNumber serves eth_blockNumber.
+func (s *Server) ethBlockNumber(context.Context, []json.RawMessage) (any, error) {
+	return math.U64(s.chain.headNumber()), nil
+}
+
+// filterQuery is the filter of eth_getLogs.
+type filterQuery struct {
+	BlockHash *common.ExecutionHash     `json:"blockHash"`
+	FromBlock string                    `json:"fromBlock"`
+	ToBlock   string                    `json:"toBlock"`
+	Addresses []common.ExecutionAddress `json:"address"`
+	Topics    [][]common.ExecutionHash  `json:"topics"`
+}
+
+// ethGetLogs serves eth_getLogs. Only the Deposit events of the deposit
+// contract are ever emitted.
+func (s *Server) ethGetLogs(_ context.Context, params []json.RawMessage) (any, error) {
+	if err := checkNumParams(params, 1); err != nil {
+		return nil, err
+	}
+	var q filterQuery
+	if err := decodeParams(params, &q); err != nil {
+		return nil, err
+	}
+
+	logs := make([]*gethprimitives.Log, 0)
+	if !s.matchesDepositLogs(&q) {
+		return logs, nil
+	}
+	from, err := s.blockNumber(q.FromBlock, 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.blockNumber(q.ToBlock, s.chain.headNumber())
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range s.chain.depositLogs(from, to) {
+		if q.BlockHash != nil && *q.BlockHash != l.blockHash {
+			continue
+		}
+		log, errLog := s.depositLog(l)
+		if errLog != nil {
+			return nil, errLog
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// matchesDepositLogs returns whether the deposit logs match the address and
+// the first topic of the filter.
+func (s *Server) matchesDepositLogs(q *filterQuery) bool {
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, s.cfg.DepositContract) {
+		return false
+	}
+	if len(q.Topics) > 0 && len(q.Topics[0]) > 0 &&
+		!slices.Contains(q.Topics[0], common.ExecutionHash(s.depositEvent.ID)) {
+		return false
+	}
+	return true
+}
+
+// depositLog encodes the deposit as a Deposit event of the deposit contract.
+func (s *Server) depositLog(l depositLog) (*gethprimitives.Log, error) {
+	d := l.deposit
+	data, err := s.depositEvent.Inputs.NonIndexed().Pack(
+		d.Pubkey[:], d.Credentials[:], d.Amount.Unwrap(), d.Signature[:], d.Index,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Deposits are emitted by a fake transaction each, identified by the
+	// deposit index.
+	txHash := sha256.Sum256(binary.BigEndian.AppendUint64([]byte("deposit"), d.Index))
+	return &gethprimitives.Log{
+		Address:     gethprimitives.ExecutionAddress(s.cfg.DepositContract),
+		Topics:      []gethprimitives.ExecutionHash{s.depositEvent.ID},
+		Data:        data,
+		BlockNumber: l.blockNumber,
+		TxHash:      txHash,
+		BlockHash:   gethprimitives.ExecutionHash(l.blockHash),
+		Index:       uint(d.Index),
+	}, nil
+}
+
+// blockNumber decodes a block number of a filter, defaulting to def.
+func (s *Server) blockNumber(tag string, def uint64) (uint64, error) {
+	switch tag {
+	case "":
+		return def, nil
+	case "earliest":
+		return 0, nil
+	case "latest", "safe", "finalized", "pending":
+		return s.chain.headNumber(), nil
+	}
+	number, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return 0, errors.Wrapf(errInvalidParams, "block number %q: %v", tag, err)
+	}
+	return number, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// checkNumParams checks that at least the given number of params is passed.
+func checkNumParams(params []json.RawMessage, n int) error {
+	if len(params) < n {
+		return errors.Wrapf(errInvalidParams, "expected %d params, got %d", n, len(params))
+	}
+	return nil
+}
+
+// decodeParams decodes the params in the given targets, in order.
+func decodeParams(params []json.RawMessage, targets ...any) error {
+	for i, target := range targets {
+		if err := json.Unmarshal(params[i], target); err != nil {
+			return errors.Wrapf(errInvalidParams, "param %d: %v", i, err)
+		}
+	}
+	return nil
+}
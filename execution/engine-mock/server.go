@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginemock
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gjwt "github.com/golang-jwt/jwt/v5"
+)
+
+// readHeaderTimeout bounds the time to read the headers of a request.
+const readHeaderTimeout = 5 * time.Second
+
+// Server is an in-process execution client serving the subset of the Engine
+// and Eth JSON-RPC APIs used by beacon-kit on top of a deterministic fake
+// chain. It is meant for tests and devnets that do not need real execution.
+type Server struct {
+	cfg     Config
+	chainID uint64
+	chain   *chain
+
+	// depositEvent is the Deposit event of the deposit contract.
+	depositEvent abi.Event
+	// methods are the JSON-RPC methods served, by name.
+	methods map[string]method
+
+	faultsMu sync.RWMutex
+	faults   Faults
+
+	srv      *http.Server
+	listener net.Listener
+}
+
+// method is a JSON-RPC method handler.
+type method func(ctx context.Context, params []json.RawMessage) (any, error)
+
+// request is a JSON-RPC request whose params are decoded by the handlers.
+type request struct {
+	ID     int               `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// New creates a mock engine on top of the genesis of the given config.
+func New(cfg Config) (*Server, error) {
+	if cfg.Genesis == nil {
+		return nil, ErrNilGenesis
+	}
+	if cfg.Genesis.Config == nil || cfg.Genesis.Config.ChainID == nil ||
+		!cfg.Genesis.Config.ChainID.IsUint64() {
+		return nil, ErrNilChainID
+	}
+	if err := cfg.Faults.validate(); err != nil {
+		return nil, err
+	}
+	depositABI, err := deposit.DepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		cfg:          cfg,
+		chainID:      cfg.Genesis.Config.ChainID.Uint64(),
+		chain:        newChain(cfg.Genesis.ToBlock()),
+		depositEvent: depositABI.Events["Deposit"],
+		faults:       cfg.Faults,
+	}
+	s.methods = s.registerMethods()
+	return s, nil
+}
+
+// Start serves the mock engine on the given address, e.g. "127.0.0.1:0".
+func (s *Server) Start(addr string) error {
+	if s.srv != nil {
+		return ErrAlreadyStarted
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	s.srv = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		//#nosec:G104 // the error is ErrServerClosed once stopped.
+		_ = s.srv.Serve(listener)
+	}()
+	return nil
+}
+
+// Stop stops serving the mock engine.
+func (s *Server) Stop() error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Close()
+}
+
+// URL returns the URL the mock engine is served at, once started.
+func (s *Server) URL() string {
+	if s.listener == nil {
+		return ""
+	}
+	return "http://" + s.listener.Addr().String()
+}
+
+// ChainID returns the chain ID of the mock engine.
+func (s *Server) ChainID() uint64 {
+	return s.chainID
+}
+
+// SetFaults replaces the faults injected by the mock engine.
+func (s *Server) SetFaults(faults Faults) error {
+	if err := faults.validate(); err != nil {
+		return err
+	}
+	s.faultsMu.Lock()
+	defer s.faultsMu.Unlock()
+	s.faults = faults
+	return nil
+}
+
+// AddDeposit emits the given deposit from the deposit contract in the next
+// block inserted by newPayload. The deposit index is assigned by the mock
+// engine in order of emission.
+func (s *Server) AddDeposit(d *ctypes.Deposit) {
+	s.chain.addDeposit(d)
+}
+
+// Forkchoice returns the last forkchoice state applied to the fake chain.
+func (s *Server) Forkchoice() *engineprimitives.ForkchoiceStateV1 {
+	return s.chain.forkchoice()
+}
+
+// ServeHTTP serves a JSON-RPC request.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authenticate(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	faults := s.getFaults()
+	if faults.Latency > 0 {
+		select {
+		case <-time.After(faults.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	res := &rpc.Response{JSONRPC: "2.0"}
+	body, err := io.ReadAll(r.Body)
+	req := new(request)
+	if err == nil {
+		err = json.Unmarshal(body, req)
+	}
+	if err != nil {
+		res.Error = &rpc.Error{Code: codeParseError, Message: err.Error()}
+	} else {
+		res.ID = req.ID
+		res.Result, res.Error = s.call(r.Context(), req)
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	//#nosec:G104 // nothing to do if the client went away.
+	_, _ = w.Write(bz)
+}
+
+// call dispatches the request to its method handler.
+func (s *Server) call(ctx context.Context, req *request) (json.RawMessage, *rpc.Error) {
+	m, ok := s.methods[req.Method]
+	if !ok {
+		return nil, rpcError(errors.Wrap(errMethodNotFound, req.Method))
+	}
+	result, err := m(ctx, req.Params)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return bz, nil
+}
+
+// authenticate checks the JWT of the request, if a secret is configured.
+func (s *Server) authenticate(r *http.Request) bool {
+	if s.cfg.JWTSecret == nil {
+		return true
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	parsed, err := gjwt.Parse(
+		token,
+		func(*gjwt.Token) (any, error) { return s.cfg.JWTSecret.Bytes(), nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+		gjwt.WithIssuedAt(),
+	)
+	return err == nil && parsed.Valid
+}
+
+func (s *Server) getFaults() Faults {
+	s.faultsMu.RLock()
+	defer s.faultsMu.RUnlock()
+	return s.faults
+}
+
+// rpcError maps an error to its JSON-RPC error.
+func rpcError(err error) *rpc.Error {
+	code := codeInternalError
+	switch {
+	case errors.Is(err, errMethodNotFound):
+		code = codeMethodNotFound
+	case errors.Is(err, errInvalidParams):
+		code = codeInvalidParams
+	case errors.Is(err, engineerrors.ErrUnknownPayload):
+		code = codeUnknownPayload
+	case errors.Is(err, engineerrors.ErrInvalidPayloadAttributes):
+		code = codeInvalidAttrs
+	}
+	return &rpc.Error{Code: code, Message: err.Error()}
+}
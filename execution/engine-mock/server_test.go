@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package enginemock_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/deposit"
+	enginemock "github.com/berachain/beacon-kit/execution/engine-mock"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+var depositContract = common.NewExecutionAddressFromHex("0x4242424242424242424242424242424242424242")
+
+func TestMockEngineBuildsChain(t *testing.T) {
+	t.Parallel()
+	server, ec := startMockEngine(t)
+	ctx := context.Background()
+	genesis := server.Forkchoice().HeadBlockHash
+
+	// Build and insert a block on top of genesis, emitting a deposit.
+	d := &ctypes.Deposit{Amount: 32e9}
+	server.AddDeposit(d)
+	payload := buildPayload(t, ec, genesis, 2)
+	require.Equal(t, genesis, payload.GetParentHash())
+	require.Equal(t, math.U64(1), payload.GetNumber())
+
+	root := common.Root{0x01}
+	status, err := ec.NewPayloadV3(ctx, payload, nil, &root)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+
+	_, err = ec.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      payload.GetBlockHash(),
+		SafeBlockHash:      payload.GetBlockHash(),
+		FinalizedBlockHash: payload.GetBlockHash(),
+	}, nil, version.Deneb1())
+	require.NoError(t, err)
+	require.Equal(t, payload.GetBlockHash(), server.Forkchoice().HeadBlockHash)
+
+	// The new head is reported through the eth namespace.
+	progress, err := ec.Syncing(ctx)
+	require.NoError(t, err)
+	require.Nil(t, progress)
+	head, err := ec.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, payload.GetNumber(), head)
+
+	// The deposit is emitted by the deposit contract in the inserted block.
+	contract, err := deposit.NewWrappedDepositContract(depositContract, ec.Client)
+	require.NoError(t, err)
+	deposits, err := contract.ReadDeposits(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.True(t, deposits[0].Equals(d))
+	deposits, err = contract.ReadDeposits(ctx, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+
+	// A payload with a tampered block hash is invalid.
+	next := buildPayload(t, ec, payload.GetBlockHash(), 4)
+	next.BlockHash = common.ExecutionHash{0xff}
+	status, err = ec.NewPayloadV3(ctx, next, nil, &root)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusInvalid, status.Status)
+
+	// Payloads on top of unknown blocks are reported as syncing.
+	next.ParentHash = common.ExecutionHash{0xee}
+	status, err = ec.NewPayloadV3(ctx, next, nil, &root)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusSyncing, status.Status)
+}
+
+func TestMockEngineCellProofs(t *testing.T) {
+	t.Parallel()
+	server, ec := startMockEngine(t)
+	ctx := context.Background()
+	head := server.Forkchoice().HeadBlockHash
+	payloadID, err := ec.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: head,
+	}, &engineprimitives.PayloadAttributes{
+		Timestamp:             math.U64(2),
+		Withdrawals:           engineprimitives.Withdrawals{},
+		ParentBeaconBlockRoot: common.Root{0x01},
+	}, version.Electra())
+	require.NoError(t, err)
+	require.NotNil(t, payloadID)
+
+	// engine_getPayloadV5 returns a blobs bundle with cell proofs.
+	env, err := ec.GetPayload(ctx, *payloadID, version.Electra(), true)
+	require.NoError(t, err)
+	require.IsType(t, &engineprimitives.BlobsBundleV2{}, env.GetBlobsBundle())
+}
+
+func TestMockEngineFaults(t *testing.T) {
+	t.Parallel()
+	server, ec := startMockEngine(t)
+	ctx := context.Background()
+	state := server.Forkchoice()
+
+	require.NoError(t, server.SetFaults(enginemock.Faults{
+		Status: engineprimitives.PayloadStatusSyncing,
+	}))
+	_, err := ec.ForkchoiceUpdated(ctx, state, nil, version.Deneb1())
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+
+	require.NoError(t, server.SetFaults(enginemock.Faults{Latency: 50 * time.Millisecond}))
+	start := time.Now()
+	_, err = ec.ForkchoiceUpdated(ctx, state, nil, version.Deneb1())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	require.Error(t, server.SetFaults(enginemock.Faults{Status: "VALID"}))
+
+	_, err = ec.GetPayload(ctx, engineprimitives.PayloadID{0x01}, version.Deneb1(), false)
+	require.Error(t, err)
+}
+
+// startMockEngine starts a mock engine on the test genesis and connects an
+// engine client to it.
+func startMockEngine(t *testing.T) (*enginemock.Server, *client.EngineClient) {
+	t.Helper()
+	bz, err := afero.ReadFile(afero.NewOsFs(), "../../testing/files/eth-genesis.json")
+	require.NoError(t, err)
+	genesis := &gethprimitives.Genesis{}
+	require.NoError(t, genesis.UnmarshalJSON(bz))
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	server, err := enginemock.New(enginemock.Config{
+		Genesis:         genesis,
+		DepositContract: depositContract,
+		JWTSecret:       secret,
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start("127.0.0.1:0"))
+	t.Cleanup(func() { require.NoError(t, server.Stop()) })
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL, err = url.NewFromRaw(server.URL())
+	require.NoError(t, err)
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	ec := client.New(
+		&cfg, noop.NewLogger[any](), secret, metrics.NewNoOpTelemetrySink(),
+		new(big.Int).SetUint64(server.ChainID()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+	return server, ec
+}
+
+// buildPayload builds a payload on top of the given head at the given
+// timestamp through the engine client.
+func buildPayload(
+	t *testing.T, ec *client.EngineClient, head common.ExecutionHash, timestamp uint64,
+) *ctypes.ExecutionPayload {
+	t.Helper()
+	ctx := context.Background()
+	payloadID, err := ec.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: head,
+	}, &engineprimitives.PayloadAttributes{
+		Timestamp:             math.U64(timestamp),
+		PrevRandao:            common.Bytes32{0x02},
+		SuggestedFeeRecipient: common.ExecutionAddress{0x03},
+		Withdrawals:           engineprimitives.Withdrawals{},
+		ParentBeaconBlockRoot: common.Root{0x01},
+	}, version.Deneb1())
+	require.NoError(t, err)
+	require.NotNil(t, payloadID)
+
+	env, err := ec.GetPayload(ctx, *payloadID, version.Deneb1(), false)
+	require.NoError(t, err)
+	return env.GetExecutionPayload()
+}
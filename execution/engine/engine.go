@@ -66,6 +66,7 @@ func (ee *Engine) GetPayload(
 	return ee.ec.GetPayload(
 		ctx, req.PayloadID,
 		req.ForkVersion,
+		req.CellProofs,
 	)
 }
 
@@ -115,13 +116,13 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 
 			case errors.IsAny(err, engineerrors.ErrSyncingPayloadStatus):
 				ee.logger.Info("NotifyForkchoiceUpdate: EL syncing. Retrying...")
-				ee.metrics.markForkchoiceUpdateSyncing(req.State, err)
+				ee.metrics.markForkchoiceUpdateSyncing(req.State)
 				return nil, err
 
 			case client.IsNonFatalError(err):
 				ee.logger.Info(
 					"NotifyForkchoiceUpdate: EL returns non fatal error. Retrying...",
-					"err", err,
+					log.KeyError, err,
 				)
 				ee.metrics.markForkchoiceUpdateNonFatalError(err)
 				return nil, err
@@ -136,7 +137,7 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 			case client.IsFatalError(err):
 				ee.logger.Info(
 					"NotifyForkchoiceUpdate: EL returns fatal error.",
-					"err", err,
+					log.KeyError, err,
 				)
 				ee.metrics.markForkchoiceUpdateFatalError(err)
 				return nil, backoff.Permanent(err)
@@ -144,7 +145,7 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 			default:
 				ee.logger.Info(
 					"NotifyForkchoiceUpdate: EL returns unknown error.",
-					"err", err,
+					log.KeyError, err,
 				)
 				ee.metrics.markForkchoiceUpdateUndefinedError(err)
 				return nil, backoff.Permanent(err)
@@ -173,7 +174,7 @@ func (ee *Engine) NotifyNewPayload(
 	_, err := backoff.Retry(
 		ctx,
 		func() (*common.ExecutionHash, error) {
-			ee.metrics.markNewPayloadCalled(payloadHash, payloadParentHash)
+			ee.metrics.markNewPayloadCalled()
 			lastValidHash, err := ee.ec.NewPayload(
 				ctx, req,
 			)
@@ -191,7 +192,7 @@ func (ee *Engine) NotifyNewPayload(
 			case errors.IsAny(err, engineerrors.ErrSyncingPayloadStatus, engineerrors.ErrAcceptedPayloadStatus):
 				ee.logger.Info(
 					"NotifyNewPayload: EL returns non valid status. Retrying...",
-					"err", err,
+					log.KeyError, err,
 				)
 				ee.metrics.markNewPayloadAcceptedSyncingPayloadStatus(err, payloadHash, payloadParentHash)
 				// During ProcessProposal, we must be able to verify the
@@ -210,14 +211,14 @@ func (ee *Engine) NotifyNewPayload(
 					"NotifyNewPayload: pushed new payload to SYNCING node.",
 					"error", err,
 					"blockNum", req.GetExecutionPayload().GetNumber(),
-					"blockHash", payloadHash,
+					log.KeyBlockHash, payloadHash,
 				)
 				return &common.ExecutionHash{}, nil
 
 			case client.IsNonFatalError(err):
 				ee.logger.Info(
 					"NotifyNewPayload: EL returns non fatal error. Retrying...",
-					"err", err,
+					log.KeyError, err,
 				)
 				// Protect against possible nil value.
 				if lastValidHash == nil {
@@ -238,7 +239,7 @@ func (ee *Engine) NotifyNewPayload(
 			case client.IsFatalError(err):
 				ee.logger.Error(
 					"NotifyNewPayload: EL returns fatal error.",
-					"err", err,
+					log.KeyError, err,
 				)
 				// Protect against possible nil value.
 				if lastValidHash == nil {
@@ -249,7 +250,7 @@ func (ee *Engine) NotifyNewPayload(
 			default:
 				ee.logger.Error(
 					"NotifyNewPayload: EL returns unknown error.",
-					"err", err,
+					log.KeyError, err,
 				)
 				ee.metrics.markNewPayloadUndefinedError(payloadHash, err)
 				// Do not retry on unknown errors.
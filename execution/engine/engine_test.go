@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// recording is an Engine API recording in the format written by
+// rpc.Recorder: the EL reports SYNCING on the first forkchoice update, VALID
+// on the retry and then rejects the next payload.
+const recording = `
+{"time":"2025-01-01T00:00:00Z","method":"engine_forkchoiceUpdatedV3","params":[],"latency":1000000,` +
+	`"result":{"payloadStatus":{"status":"SYNCING","latestValidHash":null,"validationError":null},"payloadId":null}}
+{"time":"2025-01-01T00:00:01Z","method":"engine_forkchoiceUpdatedV3","params":[],"latency":1000000,` +
+	`"result":{"payloadStatus":{"status":"VALID","latestValidHash":null,"validationError":null},` +
+	`"payloadId":"0x0102030405060708"}}
+{"time":"2025-01-01T00:00:02Z","method":"engine_newPayloadV3","params":[],"latency":1000000,` +
+	`"result":{"status":"INVALID","latestValidHash":null,"validationError":"bad block"}}
+`
+
+func newReplayEngine(t *testing.T) (*engine.Engine, *rpc.Replayer) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(recording), 0o600))
+	replayer, err := rpc.NewReplayerFromFile(path)
+	require.NoError(t, err)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCRetryInterval = time.Millisecond
+	cfg.RPCMaxRetryInterval = time.Millisecond
+	ec := client.NewFromRPCClient(
+		&cfg, noop.NewLogger[any](), replayer, metrics.NewNoOpTelemetrySink(), big.NewInt(80087),
+	)
+	return engine.New(ec, noop.NewLogger[any](), metrics.NewNoOpTelemetrySink()), replayer
+}
+
+func TestEngineReplay(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ee, replayer := newReplayEngine(t)
+
+	// The SYNCING response is retried until the EL reports VALID.
+	payloadID, err := ee.NotifyForkchoiceUpdate(ctx, &ctypes.ForkchoiceUpdateRequest{
+		State:             &engineprimitives.ForkchoiceStateV1{},
+		PayloadAttributes: &engineprimitives.PayloadAttributes{},
+		ForkVersion:       version.Deneb1(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{1, 2, 3, 4, 5, 6, 7, 8}, *payloadID)
+
+	// The INVALID response is not retried.
+	req, err := ctypes.BuildNewPayloadRequestFromFork(
+		utils.GenerateValidBeaconBlock(t, version.Deneb1()),
+	)
+	require.NoError(t, err)
+	err = ee.NotifyNewPayload(ctx, req, true)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+	require.Zero(t, replayer.Remaining())
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log
+
+// Keys of the structured fields shared across log call sites, so entries can
+// be queried by the same key whatever logged them.
+const (
+	// KeyService is the key of the service of a logger. It sets the module
+	// of the logger for module levels.
+	KeyService = "service"
+	// KeyModule is the key of the submodule of a logger, nested under its
+	// service for module levels.
+	KeyModule = "module"
+	// KeyError is the key of an error.
+	KeyError = "error"
+	// KeyHeight is the key of a CometBFT block height.
+	KeyHeight = "height"
+	// KeySlot is the key of a beacon slot.
+	KeySlot = "slot"
+	// KeyEpoch is the key of a beacon epoch.
+	KeyEpoch = "epoch"
+	// KeyBlockRoot is the key of the root of a beacon block.
+	KeyBlockRoot = "block_root"
+	// KeyStateRoot is the key of the root of a beacon state.
+	KeyStateRoot = "state_root"
+	// KeyBlockHash is the key of the hash of an execution block.
+	KeyBlockHash = "block_hash"
+	// KeyValidatorIndex is the key of the index of a validator.
+	KeyValidatorIndex = "validator_index"
+	// KeyPubkey is the key of the public key of a validator.
+	KeyPubkey = "pubkey"
+)
@@ -20,6 +20,13 @@
 
 package phuslu
 
+import "time"
+
+const (
+	defaultSamplingInterval   = time.Second
+	defaultSamplingThereafter = 100
+)
+
 // Config is a structure that defines the configuration for the logger.
 type Config struct {
 	// TimeFormat is a string that defines the format of the time in
@@ -29,6 +36,20 @@ type Config struct {
 	LogLevel string `mapstructure:"log-level"`
 	// pretty or json.
 	Style string `mapstructure:"style"`
+	// ModuleLevels overrides LogLevel for modules, as a comma separated list
+	// of module=level pairs, e.g. "blockchain=debug,comet=warn". A module is
+	// the service of a logger, optionally followed by a dot separated
+	// submodule, and inherits the level of its parent module.
+	ModuleLevels string `mapstructure:"module-levels"`
+	// SamplingBurst is the number of debug and info entries with the same
+	// message logged in each SamplingInterval before sampling starts. 0
+	// disables sampling.
+	SamplingBurst uint64 `mapstructure:"sampling-burst"`
+	// SamplingThereafter is the sampling rate past SamplingBurst: only every
+	// SamplingThereafter-th entry with the same message is logged.
+	SamplingThereafter uint64 `mapstructure:"sampling-thereafter"`
+	// SamplingInterval is the interval sampling counters are reset at.
+	SamplingInterval time.Duration `mapstructure:"sampling-interval"`
 }
 
 // DefaultConfig is a function that returns a new Config with default values.
@@ -37,5 +58,8 @@ func DefaultConfig() Config {
 		TimeFormat: "RFC3339",
 		LogLevel:   "info",
 		Style:      StylePretty,
+
+		SamplingThereafter: defaultSamplingThereafter,
+		SamplingInterval:   defaultSamplingInterval,
 	}
 }
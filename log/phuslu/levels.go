@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"fmt"
+	"strings"
+
+	beaconlog "github.com/berachain/beacon-kit/log"
+	"github.com/phuslu/log"
+)
+
+// levels holds the log level of each module.
+type levels struct {
+	// fallback is the level of the modules without a level of their own.
+	fallback log.Level
+	// modules maps modules to their level.
+	modules map[string]log.Level
+}
+
+// newLevels parses the module levels of the given comma separated
+// module=level pairs, falling back to the given level.
+func newLevels(fallback string, moduleLevels string) (*levels, error) {
+	lv := &levels{
+		fallback: parseLevel(fallback),
+		modules:  make(map[string]log.Level),
+	}
+	for _, pair := range strings.Split(moduleLevels, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		module, name, ok := strings.Cut(pair, "=")
+		module, name = strings.TrimSpace(module), strings.TrimSpace(name)
+		if !ok || module == "" {
+			return nil, fmt.Errorf("invalid module level %q", pair)
+		}
+		level := log.ParseLevel(name)
+		if level.String() == "????" {
+			return nil, fmt.Errorf("invalid log level %q for module %s", name, module)
+		}
+		lv.modules[module] = level
+	}
+	return lv, nil
+}
+
+// of returns the level of the given module, which is the level of its
+// closest parent module with a level of its own.
+func (lv *levels) of(module string) log.Level {
+	if len(lv.modules) == 0 {
+		return lv.fallback
+	}
+	for module != "" {
+		if level, ok := lv.modules[module]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(module, '.')
+		if i < 0 {
+			break
+		}
+		module = module[:i]
+	}
+	return lv.fallback
+}
+
+// parseLevel parses the given level, defaulting to info.
+func parseLevel(name string) log.Level {
+	level := log.ParseLevel(name)
+	if level.String() == "????" {
+		return log.InfoLevel
+	}
+	return level
+}
+
+// subModule returns the module of a logger given the module of its parent
+// and a context key-value pair.
+func subModule(parent string, key string, value any) string {
+	switch key {
+	case beaconlog.KeyService:
+		return fmt.Sprint(value)
+	case beaconlog.KeyModule:
+		if parent == "" {
+			return fmt.Sprint(value)
+		}
+		return parent + "." + fmt.Sprint(value)
+	default:
+		return parent
+	}
+}
@@ -21,7 +21,10 @@
 package phuslu
 
 import (
+	"fmt"
 	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/phuslu/log"
 )
@@ -36,6 +39,19 @@ type Logger struct {
 	out io.Writer
 	// formatter is the formatter to use for the logger.
 	formatter *Formatter
+	// module is the module of the logger, set by the service and module
+	// context keys.
+	module string
+	// shared holds the settings shared with the loggers derived from it.
+	shared *shared
+}
+
+// shared holds the settings of a logger that apply to the loggers derived
+// from it, as they may be configured after the derived loggers are created.
+type shared struct {
+	levels  atomic.Pointer[levels]
+	sampler atomic.Pointer[sampler]
+	sink    atomic.Pointer[Sink]
 }
 
 // NewLogger initializes a new wrapped phuslogger with the provided config.
@@ -44,10 +60,13 @@ func NewLogger(
 	cfg *Config,
 ) *Logger {
 	logger := &Logger{
-		logger:    &log.Logger{},
+		// Entries are filtered by the levels of their module before they
+		// reach the underlying logger, which may thus log at every level.
+		logger:    &log.Logger{Level: log.TraceLevel},
 		context:   make(log.Fields),
 		out:       out,
 		formatter: NewFormatter(),
+		shared:    &shared{},
 	}
 	logger.WithConfig(cfg)
 	return logger
@@ -55,34 +74,37 @@ func NewLogger(
 
 // Info logs a message at level Info.
 func (l *Logger) Info(msg string, keyVals ...any) {
-	if l.logger.Level > log.InfoLevel {
-		return
-	}
-	l.msgWithContext(msg, l.logger.Info(), keyVals...)
+	l.log(log.InfoLevel, msg, keyVals)
 }
 
 // Warn logs a message at level Warn.
 func (l *Logger) Warn(msg string, keyVals ...any) {
-	if l.logger.Level > log.WarnLevel {
-		return
-	}
-	l.msgWithContext(msg, l.logger.Warn(), keyVals...)
+	l.log(log.WarnLevel, msg, keyVals)
 }
 
 // Error logs a message at level Error.
 func (l *Logger) Error(msg string, keyVals ...any) {
-	if l.logger.Level > log.ErrorLevel {
-		return
-	}
-	l.msgWithContext(msg, l.logger.Error(), keyVals...)
+	l.log(log.ErrorLevel, msg, keyVals)
 }
 
 // Debug logs a message at level Debug.
 func (l *Logger) Debug(msg string, keyVals ...any) {
-	if l.logger.Level > log.DebugLevel {
+	l.log(log.DebugLevel, msg, keyVals)
+}
+
+// Enabled reports whether the logger logs messages at the given level.
+func (l *Logger) Enabled(level log.Level) bool {
+	return level >= l.shared.levels.Load().of(l.module)
+}
+
+// SetSink sets the sink the entries of the logger and of the loggers derived
+// from it are forwarded to. A nil sink stops forwarding.
+func (l *Logger) SetSink(sink Sink) {
+	if sink == nil {
+		l.shared.sink.Store(nil)
 		return
 	}
-	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
+	l.shared.sink.Store(&sink)
 }
 
 // Impl returns the underlying logger implementation.
@@ -101,12 +123,13 @@ func (l Logger) With(keyVals ...any) *Logger {
 	}
 
 	// Add the new context to the existing context.
-	for i := 0; i < len(keyVals); i += 2 {
+	for i := 0; i+1 < len(keyVals); i += 2 {
 		key, ok := keyVals[i].(string)
 		if !ok {
 			continue
 		}
 		newLogger.context[key] = keyVals[i+1]
+		newLogger.module = subModule(newLogger.module, key, keyVals[i+1])
 	}
 
 	return &newLogger
@@ -117,6 +140,29 @@ func (l *Logger) Writer() io.Writer {
 	return l.out
 }
 
+// log logs a message at the given level, unless the level is disabled for
+// the module of the logger or the message is sampled out.
+func (l *Logger) log(level log.Level, msg string, keyVals []any) {
+	if !l.Enabled(level) {
+		return
+	}
+	var now time.Time
+	if s := l.shared.sampler.Load(); s != nil && level < log.WarnLevel {
+		now = time.Now()
+		if !s.allow(msg, now) {
+			return
+		}
+	}
+	l.msgWithContext(msg, l.logger.WithLevel(level), keyVals...)
+
+	if sink := l.shared.sink.Load(); sink != nil {
+		if now.IsZero() {
+			now = time.Now()
+		}
+		(*sink).Write(l.record(now, level, msg, keyVals))
+	}
+}
+
 // msgWithContext logs a message with keyVals and current context.
 func (l *Logger) msgWithContext(
 	msg string, e *log.Entry, keyVals ...any,
@@ -124,6 +170,28 @@ func (l *Logger) msgWithContext(
 	e.Fields(l.context).KeysAndValues(keyVals...).Msg(msg)
 }
 
+// record returns the record of an entry for the sink of the logger.
+func (l *Logger) record(
+	now time.Time, level log.Level, msg string, keyVals []any,
+) *Record {
+	fields := make(map[string]any, len(l.context)+len(keyVals)/2)
+	for k, v := range l.context {
+		fields[k] = v
+	}
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if key, ok := keyVals[i].(string); ok {
+			fields[key] = keyVals[i+1]
+		}
+	}
+	return &Record{
+		Time:    now,
+		Level:   level.String(),
+		Module:  l.module,
+		Message: msg,
+		Fields:  fields,
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   config                                   */
 /* -------------------------------------------------------------------------- */
@@ -138,7 +206,13 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 	}
 	l.withTimeFormat(cfg.TimeFormat)
 	l.withStyle(cfg.Style)
-	l.withLogLevel(cfg.LogLevel)
+	err := l.withLevels(cfg.LogLevel, cfg.ModuleLevels)
+	l.shared.sampler.Store(newSampler(
+		cfg.SamplingBurst, cfg.SamplingThereafter, cfg.SamplingInterval,
+	))
+	if err != nil {
+		l.Warn("Ignoring module log levels", "error", err)
+	}
 	return l
 }
 
@@ -164,9 +238,42 @@ func (l *Logger) withStyle(style string) {
 	}
 }
 
-// SetLevel sets the log level of the logger.
-func (l *Logger) withLogLevel(level string) {
-	l.logger.Level = log.ParseLevel(level)
+// withLevels sets the log level of the logger and the levels of the
+// modules. The module levels are ignored if they are invalid.
+func (l *Logger) withLevels(level string, moduleLevels string) error {
+	lv, err := newLevels(level, moduleLevels)
+	if err != nil {
+		lv, _ = newLevels(level, "")
+	}
+	l.shared.levels.Store(lv)
+	return err
+}
+
+// SetLevels sets the log level of the logger, and of the loggers derived
+// from it, along with the levels of the modules given as comma separated
+// module=level pairs. Unlike the config, invalid levels are rejected and
+// leave the current levels unchanged.
+func (l *Logger) SetLevels(level string, moduleLevels string) error {
+	if log.ParseLevel(level).String() == "????" {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+	lv, err := newLevels(level, moduleLevels)
+	if err != nil {
+		return err
+	}
+	l.shared.levels.Store(lv)
+	l.Info("Updated log levels", "level", level, "module_levels", moduleLevels)
+	return nil
+}
+
+// Levels returns the log level of the logger and the levels of the modules.
+func (l *Logger) Levels() (string, map[string]string) {
+	lv := l.shared.levels.Load()
+	modules := make(map[string]string, len(lv.modules))
+	for module, level := range lv.modules {
+		modules[module] = level.String()
+	}
+	return lv.fallback.String(), modules
 }
 
 // useConsoleWriter sets the logger to use a console writer.
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+// entries returns the messages of the JSON entries written to buf.
+func entries(t *testing.T, buf *bytes.Buffer) []string {
+	t.Helper()
+	var msgs []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry struct {
+			Message string `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		msgs = append(msgs, entry.Message)
+	}
+	return msgs
+}
+
+func newJSONLogger(buf *bytes.Buffer, cfg phuslu.Config) *phuslu.Logger {
+	cfg.Style = phuslu.StyleJSON
+	return phuslu.NewLogger(buf, &cfg)
+}
+
+func TestModuleLevels(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	root := phuslu.NewLogger(buf, nil)
+	blockchain := root.With("service", "blockchain")
+	engine := root.With("service", "engine.client")
+	p2p := root.With("service", "comet").With("module", "p2p")
+	consensus := root.With("service", "comet").With("module", "consensus")
+
+	// Loggers derived before the config is applied follow it.
+	cfg := phuslu.DefaultConfig()
+	cfg.Style = phuslu.StyleJSON
+	cfg.ModuleLevels = "blockchain=debug, engine=warn, comet=error, comet.p2p=info"
+	root.WithConfig(&cfg)
+
+	root.Debug("root debug")
+	root.Info("root info")
+	blockchain.Debug("blockchain debug")
+	engine.Info("engine info")
+	engine.Warn("engine warn")
+	consensus.Warn("consensus warn")
+	consensus.Error("consensus error")
+	p2p.Info("p2p info")
+
+	require.Equal(t, []string{
+		"root info",
+		"blockchain debug",
+		"engine warn",
+		"consensus error",
+		"p2p info",
+	}, entries(t, buf))
+}
+
+func TestInvalidModuleLevels(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	cfg := phuslu.DefaultConfig()
+	cfg.ModuleLevels = "blockchain=verbose"
+	logger := newJSONLogger(buf, cfg)
+	buf.Reset()
+
+	logger.With("service", "blockchain").Debug("blockchain debug")
+	logger.With("service", "blockchain").Info("blockchain info")
+	require.Equal(t, []string{"blockchain info"}, entries(t, buf))
+}
+
+func TestSetLevels(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	logger := newJSONLogger(buf, phuslu.DefaultConfig())
+	blockchain := logger.With("service", "blockchain")
+
+	require.Error(t, logger.SetLevels("verbose", ""))
+	require.Error(t, logger.SetLevels("warn", "blockchain=verbose"))
+	level, modules := logger.Levels()
+	require.Equal(t, "info", level)
+	require.Empty(t, modules)
+
+	require.NoError(t, logger.SetLevels("warn", "blockchain=debug"))
+	level, modules = logger.Levels()
+	require.Equal(t, "warn", level)
+	require.Equal(t, map[string]string{"blockchain": "debug"}, modules)
+	buf.Reset()
+
+	logger.Info("root info")
+	logger.Warn("root warn")
+	blockchain.Debug("blockchain debug")
+	require.Equal(t, []string{"root warn", "blockchain debug"}, entries(t, buf))
+}
+
+func TestSampling(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	cfg := phuslu.DefaultConfig()
+	cfg.SamplingBurst = 2
+	cfg.SamplingThereafter = 3
+	cfg.SamplingInterval = time.Hour
+	logger := newJSONLogger(buf, cfg)
+
+	for range 8 {
+		logger.Info("frequent")
+		logger.Warn("warning")
+	}
+	msgs := entries(t, buf)
+	var frequent, warnings int
+	for _, msg := range msgs {
+		switch msg {
+		case "frequent":
+			frequent++
+		case "warning":
+			warnings++
+		}
+	}
+	// The first 2 entries, then the 5th and 8th.
+	require.Equal(t, 4, frequent)
+	require.Equal(t, 8, warnings)
+}
+
+type recordingSink struct {
+	records []*phuslu.Record
+}
+
+func (s *recordingSink) Write(rec *phuslu.Record) {
+	s.records = append(s.records, rec)
+}
+
+func TestSink(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	root := newJSONLogger(buf, phuslu.DefaultConfig())
+	logger := root.With("service", "blockchain")
+	sink := &recordingSink{}
+	root.SetSink(sink)
+
+	logger.Debug("dropped")
+	logger.Info("kept", "slot", 7)
+	root.SetSink(nil)
+	logger.Info("not forwarded")
+
+	require.Len(t, sink.records, 1)
+	rec := sink.records[0]
+	require.Equal(t, "info", rec.Level)
+	require.Equal(t, "blockchain", rec.Module)
+	require.Equal(t, "kept", rec.Message)
+	require.Equal(t, map[string]any{"service": "blockchain", "slot": 7}, rec.Fields)
+}
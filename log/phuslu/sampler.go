@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"sync"
+	"time"
+)
+
+// sampler bounds the rate of entries with the same message, logging the
+// first burst entries of each interval and every thereafter-th entry past
+// them.
+type sampler struct {
+	burst      uint64
+	thereafter uint64
+	interval   time.Duration
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// start is the start of the current interval.
+	start time.Time
+	// counts maps messages to the number of entries in the interval.
+	counts map[string]uint64
+}
+
+// newSampler creates a sampler, which is nil if sampling is disabled.
+func newSampler(burst, thereafter uint64, interval time.Duration) *sampler {
+	if burst == 0 {
+		return nil
+	}
+	if interval <= 0 {
+		interval = defaultSamplingInterval
+	}
+	return &sampler{
+		burst:      burst,
+		thereafter: thereafter,
+		interval:   interval,
+		counts:     make(map[string]uint64),
+	}
+}
+
+// allow reports whether an entry with the given message logged at the given
+// time is kept.
+func (s *sampler) allow(msg string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.start) >= s.interval {
+		clear(s.counts)
+		s.start = now
+	}
+	n := s.counts[msg] + 1
+	s.counts[msg] = n
+	if n <= s.burst {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.burst)%s.thereafter == 0
+}
@@ -49,6 +49,29 @@ type Backend struct {
 
 	// genesisForkVersion is cached here, written to once during initialization!
 	genesisForkVersion atomic.Pointer[common.Version]
+
+	// cache holds the responses of hot reads, nil if caching is disabled.
+	cache *responseCache
+}
+
+// Option is a functional option for the Backend.
+type Option func(*Backend) error
+
+// WithResponseCache enables caching up to size responses of hot reads, of about
+// maxBytes bytes, both at given slots and at the head. A size of 0 disables
+// caching.
+func WithResponseCache(size, maxBytes int, sink TelemetrySink) Option {
+	return func(b *Backend) error {
+		if size == 0 {
+			return nil
+		}
+		cache, err := newResponseCache(size, maxBytes, sink)
+		if err != nil {
+			return err
+		}
+		b.cache = cache
+		return nil
+	}
 }
 
 // New creates and returns a new Backend instance.
@@ -56,11 +79,17 @@ func New(
 	storageBackend *storage.Backend,
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
+	opts ...Option,
 ) (*Backend, error) {
 	b := &Backend{
 		sb: storageBackend,
 		cs: cs,
 	}
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, err
+		}
+	}
 
 	// Load the genesis file from cometbft config.
 	appGenesis, err := genutiltypes.AppGenesisFromFile(cmtCfg.GenesisFile())
@@ -32,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/beacondb"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -43,6 +44,7 @@ type testConsensusService struct {
 	cms     storetypes.CommitMultiStore
 	kvStore *beacondb.KVStore
 	cs      chain.Spec
+	height  int64
 }
 
 func (t *testConsensusService) CreateQueryContext(height int64, _ bool) (sdk.Context, error) {
@@ -77,5 +79,25 @@ func (t *testConsensusService) Name() string {
 }
 
 func (t *testConsensusService) LastBlockHeight() int64 {
-	panic(errTestMemberNotImplemented)
+	return t.height
+}
+
+func (t *testConsensusService) LightBlock(int64, int) (*cmttypes.LightBlock, *cmttypes.TxProof, error) {
+	return nil, nil, errTestMemberNotImplemented
+}
+
+func (t *testConsensusService) RequestHalt(int64) error {
+	return errTestMemberNotImplemented
+}
+
+func (t *testConsensusService) ExpectedProposer(int64) ([]byte, error) {
+	return nil, errTestMemberNotImplemented
+}
+
+func (t *testConsensusService) IsCatchingUp() bool {
+	return false
+}
+
+func (t *testConsensusService) NetworkHeight() int64 {
+	return t.height
 }
@@ -30,6 +30,12 @@ import (
 
 // BlockHeaderAtSlot returns the block header at the given slot.
 func (b *Backend) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error) {
+	return cached(b, routeBlockHeader, slot, "", func() (*ctypes.BeaconBlockHeader, error) {
+		return b.blockHeaderAtSlot(slot)
+	})
+}
+
+func (b *Backend) blockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error) {
 	st, _, err := b.StateAtSlot(slot)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
@@ -47,6 +53,12 @@ func (b *Backend) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader,
 
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	return cached(b, routeBlockRoot, slot, "", func() (common.Root, error) {
+		return b.blockRootAtSlot(slot)
+	})
+}
+
+func (b *Backend) blockRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, _, err := b.StateAtSlot(slot)
 	if err != nil {
 		return common.Root{}, errors.Wrapf(err, "failed to get state from slot %d", slot)
@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Routes of the cached backend reads, used in cache keys and metrics.
+const (
+	routeValidators          = "validators"
+	routeValidator           = "validator"
+	routeValidatorBalances   = "validator_balances"
+	routeValidatorIdentities = "validator_identities"
+	routeBlockHeader         = "block_header"
+	routeBlockRoot           = "block_root"
+	routeFinality            = "finality_checkpoints"
+	routeRandao              = "randao"
+)
+
+// Approximate sizes in bytes of the cached responses, used to bound the memory
+// held by the cache.
+const (
+	// entrySize is the size of a response of fixed size, along with its key
+	// and the bookkeeping of the cache.
+	entrySize = 256
+	// validatorSize is the size of a validator in a list of validators.
+	validatorSize = 512
+	// validatorBalanceSize is the size of a balance in a list of balances.
+	validatorBalanceSize = 32
+	// validatorIdentitySize is the size of an identity in a list of identities.
+	validatorIdentitySize = 160
+)
+
+// cacheKey identifies a cached response.
+type cacheKey struct {
+	slot   math.Slot
+	route  string
+	params string
+}
+
+// responseCache caches the responses of backend reads. Since every committed
+// block is final, responses at a given slot never change and are kept until
+// evicted. Responses at the head (slot 0) are kept apart and are invalidated as
+// soon as a new block is finalized and committed.
+type responseCache struct {
+	// slots holds the responses of reads at a given slot.
+	slots *sizedLRU
+
+	// head holds the responses of reads at the head, valid at headHeight.
+	head       *sizedLRU
+	headHeight int64
+	headMu     sync.Mutex
+
+	metrics *cacheMetrics
+}
+
+// newResponseCache creates a response cache holding up to size responses and
+// about maxBytes bytes at given slots, and as much at the head.
+func newResponseCache(size, maxBytes int, sink TelemetrySink) (*responseCache, error) {
+	slots, err := newSizedLRU(size, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	head, err := newSizedLRU(size, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &responseCache{
+		slots:   slots,
+		head:    head,
+		metrics: newCacheMetrics(sink),
+	}, nil
+}
+
+// get returns the cached response for the given key. Head responses computed
+// before the given head height are purged first.
+func (c *responseCache) get(key cacheKey, headHeight int64) (any, bool) {
+	if key.slot != 0 {
+		return c.slots.get(key)
+	}
+
+	c.headMu.Lock()
+	defer c.headMu.Unlock()
+	if c.headHeight != headHeight {
+		c.head.purge()
+		c.headHeight = headHeight
+	}
+	return c.head.get(key)
+}
+
+// add caches the response for the given key. Head responses are only cached
+// if no block has been committed since they were computed at headHeight.
+func (c *responseCache) add(key cacheKey, v any, headHeight int64) {
+	if key.slot != 0 {
+		c.slots.add(key, v)
+	} else {
+		c.headMu.Lock()
+		if c.headHeight == headHeight {
+			c.head.add(key, v)
+		}
+		c.headMu.Unlock()
+	}
+	c.metrics.setSize(c.slots.len() + c.head.len())
+	c.metrics.setBytes(c.slots.size() + c.head.size())
+}
+
+// cached returns the response of the read identified by route and params at
+// the given slot, calling fetch on cache misses. Errors are not cached.
+//
+// Callers get their own copy of the response, so that modifying it does not
+// corrupt the cache.
+func cached[T any](
+	b *Backend, route string, slot math.Slot, params string, fetch func() (T, error),
+) (T, error) {
+	c := b.cache
+	if c == nil {
+		return fetch()
+	}
+
+	headHeight := b.node.LastBlockHeight()
+	key := cacheKey{slot: slot, route: route, params: params}
+	if v, ok := c.get(key, headHeight); ok {
+		c.metrics.markHit(route)
+		//nolint:errcheck // only values of type T are stored for a route.
+		return cloneResponse(v).(T), nil
+	}
+	c.metrics.markMiss(route)
+
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	c.add(key, cloneResponse(v), headHeight)
+	return v, nil
+}
+
+// cacheParams builds the params part of a cache key from request arguments.
+func cacheParams(args ...any) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case []string:
+			parts = append(parts, strings.Join(a, ","))
+		default:
+			parts = append(parts, fmt.Sprint(a))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// sizedLRU is an LRU cache bounded both in number of entries and in the
+// approximate size of the entries. Entries larger than the whole cache are
+// not cached.
+type sizedLRU struct {
+	mu       sync.Mutex
+	entries  *lru.Cache[cacheKey, any]
+	bytes    int
+	maxBytes int
+}
+
+// newSizedLRU creates an LRU cache holding up to size entries and about
+// maxBytes bytes.
+func newSizedLRU(size, maxBytes int) (*sizedLRU, error) {
+	c := &sizedLRU{maxBytes: maxBytes}
+	entries, err := lru.NewWithEvict(size, func(_ cacheKey, v any) {
+		c.bytes -= responseSize(v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.entries = entries
+	return c, nil
+}
+
+// get returns the entry for the given key.
+func (c *sizedLRU) get(key cacheKey) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries.Get(key)
+}
+
+// add caches the entry for the given key, evicting the least recently used
+// entries until the cache fits in its size.
+func (c *sizedLRU) add(key cacheKey, v any) {
+	size := responseSize(v)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Replacing an entry does not call the eviction callback.
+	if old, ok := c.entries.Peek(key); ok {
+		c.bytes -= responseSize(old)
+	}
+	c.entries.Add(key, v)
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.entries.RemoveOldest()
+	}
+}
+
+// purge removes all the entries.
+func (c *sizedLRU) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.Purge()
+}
+
+// len returns the number of entries.
+func (c *sizedLRU) len() int {
+	return c.entries.Len()
+}
+
+// size returns the approximate size of the entries in bytes.
+func (c *sizedLRU) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// responseSize returns the approximate size in bytes of a cached response.
+func responseSize(v any) int {
+	switch r := v.(type) {
+	case []*beacontypes.ValidatorData:
+		return entrySize + len(r)*validatorSize
+	case []*beacontypes.ValidatorBalanceData:
+		return entrySize + len(r)*validatorBalanceSize
+	case []*beacontypes.ValidatorIdentityData:
+		return entrySize + len(r)*validatorIdentitySize
+	default:
+		return entrySize
+	}
+}
+
+// cloneResponse returns a copy of a cached response sharing no memory with it.
+// Responses of value types are returned as is.
+func cloneResponse(v any) any {
+	switch r := v.(type) {
+	case *ctypes.BeaconBlockHeader:
+		if r == nil {
+			return r
+		}
+		c := *r
+		return &c
+	case *beacontypes.FinalityCheckpointsData:
+		if r == nil {
+			return r
+		}
+		return &beacontypes.FinalityCheckpointsData{
+			PreviousJustified: cloneCheckpoint(r.PreviousJustified),
+			CurrentJustified:  cloneCheckpoint(r.CurrentJustified),
+			Finalized:         cloneCheckpoint(r.Finalized),
+		}
+	case *beacontypes.ValidatorData:
+		return cloneValidatorData(r)
+	case []*beacontypes.ValidatorData:
+		c := make([]*beacontypes.ValidatorData, len(r))
+		for i, data := range r {
+			c[i] = cloneValidatorData(data)
+		}
+		return c
+	case []*beacontypes.ValidatorBalanceData:
+		c := make([]*beacontypes.ValidatorBalanceData, len(r))
+		for i, data := range r {
+			if data != nil {
+				balance := *data
+				c[i] = &balance
+			}
+		}
+		return c
+	case []*beacontypes.ValidatorIdentityData:
+		c := make([]*beacontypes.ValidatorIdentityData, len(r))
+		for i, data := range r {
+			if data != nil {
+				identity := *data
+				c[i] = &identity
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// cloneCheckpoint returns a copy of the given checkpoint.
+func cloneCheckpoint(cp *beacontypes.Checkpoint) *beacontypes.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	c := *cp
+	return &c
+}
+
+// cloneValidatorData returns a copy of the given validator data.
+func cloneValidatorData(data *beacontypes.ValidatorData) *beacontypes.ValidatorData {
+	if data == nil {
+		return nil
+	}
+	c := *data
+	if data.Validator != nil {
+		validator := *data.Validator
+		c.Validator = &validator
+	}
+	return &c
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+	b, err := backend.New(
+		sb, cs, writeTestGenesis(t),
+		backend.WithResponseCache(16, 1<<20, metrics.NewNoOpTelemetrySink()),
+	)
+	require.NoError(t, err)
+	node := &testConsensusService{cms: cms, kvStore: kvStore, cs: cs, height: 5}
+	b.AttachQueryBackend(node)
+
+	setupTestFinalityState(t, cms, kvStore, cs, 5)
+	slotRoot, err := b.BlockRootAtSlot(5)
+	require.NoError(t, err)
+	headRoot, err := b.BlockRootAtSlot(0)
+	require.NoError(t, err)
+
+	// Changing the underlying state is not visible through the cache.
+	setupTestFinalityState(t, cms, kvStore, cs, 6)
+	root, err := b.BlockRootAtSlot(5)
+	require.NoError(t, err)
+	require.Equal(t, slotRoot, root)
+	root, err = b.BlockRootAtSlot(0)
+	require.NoError(t, err)
+	require.Equal(t, headRoot, root)
+
+	// Committing a new block invalidates head responses only.
+	node.height = 6
+	root, err = b.BlockRootAtSlot(0)
+	require.NoError(t, err)
+	require.NotEqual(t, headRoot, root)
+	root, err = b.BlockRootAtSlot(5)
+	require.NoError(t, err)
+	require.Equal(t, slotRoot, root)
+}
+
+func TestResponseCacheMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+	// Large enough for a block root, too small for the list of validators.
+	b, err := backend.New(
+		sb, cs, writeTestGenesis(t),
+		backend.WithResponseCache(16, 1024, metrics.NewNoOpTelemetrySink()),
+	)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{cms: cms, kvStore: kvStore, cs: cs, height: 5})
+
+	setupTestFilteredValidatorsState(t, cms, kvStore, cs, testCacheValidators(cs, 0, 4), 5)
+	setupTestFinalityState(t, cms, kvStore, cs, 5)
+	validators, err := b.FilteredValidators(5, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, validators, 4)
+	root, err := b.BlockRootAtSlot(5)
+	require.NoError(t, err)
+
+	// The list of validators was too large to be cached, unlike the block root.
+	setupTestFilteredValidatorsState(t, cms, kvStore, cs, testCacheValidators(cs, 4, 4), 6)
+	setupTestFinalityState(t, cms, kvStore, cs, 6)
+	validators, err = b.FilteredValidators(5, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, validators, 8)
+	cachedRoot, err := b.BlockRootAtSlot(5)
+	require.NoError(t, err)
+	require.Equal(t, root, cachedRoot)
+}
+
+func TestResponseCacheReturnsCopies(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+	b, err := backend.New(
+		sb, cs, writeTestGenesis(t),
+		backend.WithResponseCache(16, 1<<20, metrics.NewNoOpTelemetrySink()),
+	)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{cms: cms, kvStore: kvStore, cs: cs, height: 5})
+	setupTestFilteredValidatorsState(t, cms, kvStore, cs, testCacheValidators(cs, 0, 2), 5)
+
+	// Modifying the responses, whether cached on a miss or served on a hit,
+	// does not change the cached responses.
+	for range 2 {
+		validators, errVals := b.FilteredValidators(5, nil, nil)
+		require.NoError(t, errVals)
+		require.Len(t, validators, 2)
+		require.Equal(t, cs.MaxEffectiveBalance().Unwrap(), validators[0].Balance)
+		require.Equal(t, constants.ValidatorStatusPendingInitialized, validators[0].Status)
+		validators[0].Balance = 0
+		validators[0].Status = constants.ValidatorStatusWithdrawalDone
+		validators[0].Validator.Slashed = true
+		validators[1] = nil
+
+		header, errHeader := b.BlockHeaderAtSlot(5)
+		require.NoError(t, errHeader)
+		require.Equal(t, math.Slot(constants.GenesisSlot), header.GetSlot())
+		header.SetSlot(42)
+	}
+	validators, err := b.FilteredValidators(5, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, validators[1])
+	require.False(t, validators[0].Validator.Slashed)
+}
+
+// testCacheValidators returns n pending validators with distinct pubkeys,
+// starting at the given index.
+func testCacheValidators(cs chain.Spec, first, n int) []*types.ValidatorData {
+	validators := make([]*types.ValidatorData, n)
+	for j := range validators {
+		i := first + j
+		validators[j] = &types.ValidatorData{
+			ValidatorBalanceData: types.ValidatorBalanceData{
+				Index:   uint64(i),
+				Balance: cs.MaxEffectiveBalance().Unwrap(),
+			},
+			Status: constants.ValidatorStatusPendingInitialized,
+			Validator: types.ValidatorFromConsensus(&ctypes.Validator{
+				Pubkey:                     [48]byte{byte(i + 1)},
+				EffectiveBalance:           cs.MaxEffectiveBalance(),
+				ActivationEligibilityEpoch: constants.FarFutureEpoch,
+				ActivationEpoch:            constants.FarFutureEpoch,
+				ExitEpoch:                  constants.FarFutureEpoch,
+				WithdrawableEpoch:          constants.FarFutureEpoch,
+			}),
+		}
+	}
+	return validators
+}
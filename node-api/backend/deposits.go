@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// PendingDepositsAtState returns at most limit deposits stored in the deposit store which
+// have been observed on the execution layer but not yet included in the given beacon state,
+// i.e. those whose index is greater than or equal to the state's eth1 deposit index.
+func (b *Backend) PendingDepositsAtState(
+	st *statedb.StateDB, limit uint64,
+) ([]*types.PendingDepositData, error) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get eth1 deposit index from state")
+	}
+
+	// Deposits are read in batches of the maximum number of deposits per block until the
+	// deposit store has no more deposits to return or the limit is reached.
+	var (
+		batchSize = b.cs.MaxDepositsPerBlock()
+		pending   = make([]*types.PendingDepositData, 0, min(batchSize, limit))
+	)
+	for {
+		//#nosec: G115 // pending length is bounded by limit.
+		remaining := limit - uint64(len(pending))
+		if remaining == 0 {
+			return pending, nil
+		}
+		batchSize = min(batchSize, remaining)
+		deposits, _, errDep := b.sb.DepositStore().GetDepositsByIndex(
+			context.Background(), depositIndex, batchSize,
+		)
+		if errDep != nil {
+			return nil, errors.Wrapf(errDep, "failed to get deposits from index %d", depositIndex)
+		}
+		for _, deposit := range deposits {
+			pending = append(pending, types.PendingDepositFromConsensus(deposit))
+		}
+		//#nosec: G115 // deposits length is bounded by batchSize.
+		if uint64(len(deposits)) < batchSize {
+			return pending, nil
+		}
+		depositIndex += batchSize
+	}
+}
+
+// DepositSnapshot returns the EIP-4881 snapshot of the finalized deposit tree.
+func (b *Backend) DepositSnapshot() *types.DepositSnapshotData {
+	return types.DepositSnapshotFromConsensus(b.sb.DepositStore().Snapshot())
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingDepositsAtState(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+	b, err := backend.New(sb, cs, writeTestGenesis(t))
+	require.NoError(t, err)
+
+	// Store more deposits than fit in a single block, so that pending
+	// deposits span several reads from the deposit store.
+	var (
+		totalDeposits = 2*cs.MaxDepositsPerBlock() + 3
+		includedCount = uint64(5)
+		deposits      = make([]*ctypes.Deposit, 0, totalDeposits)
+	)
+	for i := range totalDeposits {
+		deposits = append(deposits, &ctypes.Deposit{
+			Pubkey:      crypto.BLSPubkey{byte(i)},
+			Credentials: ctypes.WithdrawalCredentials{0x01},
+			Amount:      cs.MaxEffectiveBalance(),
+			Signature:   crypto.BLSSignature{byte(i)},
+			Index:       i,
+		})
+	}
+	require.NoError(t, depositStore.EnqueueDeposits(context.Background(), deposits))
+
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, st.SetEth1DepositIndex(includedCount))
+
+	pending, err := b.PendingDepositsAtState(st, totalDeposits)
+	require.NoError(t, err)
+	require.Len(t, pending, int(totalDeposits-includedCount)) // #nosec G115 -- small test values.
+	for i, deposit := range pending {
+		expected := deposits[includedCount+uint64(i)] // #nosec G115 -- small test values.
+		require.Equal(t, expected.GetIndex().Unwrap(), deposit.Index)
+		require.Equal(t, expected.GetPubkey().String(), deposit.Pubkey)
+		require.Equal(t, math.Gwei(deposit.Amount), expected.GetAmount())
+	}
+
+	// The limit caps the deposits returned, even within a single read.
+	for _, limit := range []uint64{0, 1, cs.MaxDepositsPerBlock() + 1} {
+		pending, err = b.PendingDepositsAtState(st, limit)
+		require.NoError(t, err)
+		require.Len(t, pending, int(limit)) // #nosec G115 -- small test values.
+		if limit > 0 {
+			require.Equal(t, includedCount+limit-1, pending[limit-1].Index)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// FinalityCheckpointsAtSlot returns the finality checkpoints of the state at the given slot.
+//
+// CometBFT provides single-slot finality: every committed block is final as soon as it is
+// part of the chain. Hence the checkpoint of the epoch the state belongs to is both justified
+// and finalized, while the previous justified checkpoint is the one of the prior epoch.
+func (b *Backend) FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error) {
+	return cached(b, routeFinality, slot, "", func() (*types.FinalityCheckpointsData, error) {
+		return b.finalityCheckpointsAtSlot(slot)
+	})
+}
+
+func (b *Backend) finalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error) {
+	st, resolvedSlot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+
+	currentEpoch := b.cs.SlotToEpoch(resolvedSlot)
+	current, err := b.checkpointAtEpoch(st, resolvedSlot, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// At genesis there is no prior epoch, so the previous justified checkpoint is
+	// the genesis checkpoint itself.
+	previous := current
+	if currentEpoch > 0 {
+		previous, err = b.checkpointAtEpoch(st, resolvedSlot, currentEpoch-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.FinalityCheckpointsData{
+		PreviousJustified: previous,
+		CurrentJustified:  current,
+		Finalized:         current,
+	}, nil
+}
+
+// checkpointAtEpoch builds the checkpoint for the given epoch, whose root is the root of
+// the block at the epoch boundary slot. The root is read from the block roots of the state
+// at stateSlot when still within SlotsPerHistoricalRoot, otherwise from the state committed
+// at the boundary slot itself.
+func (b *Backend) checkpointAtEpoch(
+	st *statedb.StateDB, stateSlot math.Slot, epoch math.Epoch,
+) (*types.Checkpoint, error) {
+	boundarySlot := math.Slot(epoch.Unwrap() * b.cs.SlotsPerEpoch())
+
+	var (
+		root common.Root
+		err  error
+	)
+	switch {
+	case boundarySlot == stateSlot:
+		// The root of the state's own block is not in the block roots yet, so it is
+		// derived from the latest block header after filling in its state root.
+		header, errHeader := st.GetLatestBlockHeader()
+		if errHeader != nil {
+			return nil, errors.Wrapf(errHeader, "failed to get latest block header")
+		}
+		header.SetStateRoot(st.HashTreeRoot())
+		root = header.HashTreeRoot()
+	case stateSlot.Unwrap()-boundarySlot.Unwrap() <= b.cs.SlotsPerHistoricalRoot():
+		root, err = st.GetBlockRootAtIndex(boundarySlot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
+	default:
+		root, err = b.BlockRootAtSlot(boundarySlot)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block root at slot %d", boundarySlot)
+	}
+
+	return &types.Checkpoint{
+		Epoch: epoch.Unwrap(),
+		Root:  root,
+	}, nil
+}
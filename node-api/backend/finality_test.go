@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalityCheckpointsAtSlot(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	slotsPerEpoch := cs.SlotsPerEpoch()
+
+	tests := []struct {
+		name      string
+		stateSlot math.Slot
+		// expected epochs of the previous justified and finalized checkpoints.
+		previousEpoch  math.Epoch
+		finalizedEpoch math.Epoch
+		// whether the finalized root is the root of the state's own block.
+		finalizedIsHead bool
+	}{
+		{
+			name:            "genesis",
+			stateSlot:       0,
+			previousEpoch:   0,
+			finalizedEpoch:  0,
+			finalizedIsHead: true,
+		},
+		{
+			name:           "mid epoch",
+			stateSlot:      math.Slot(3*slotsPerEpoch + 5),
+			previousEpoch:  2,
+			finalizedEpoch: 3,
+		},
+		{
+			name:            "epoch boundary",
+			stateSlot:       math.Slot(3 * slotsPerEpoch),
+			previousEpoch:   2,
+			finalizedEpoch:  3,
+			finalizedIsHead: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			b, cms, kvStore := buildFinalityTestBackend(t, cs)
+			setupTestFinalityState(t, cms, kvStore, cs, tt.stateSlot)
+
+			res, errCheckpoints := b.FinalityCheckpointsAtSlot(tt.stateSlot)
+			require.NoError(t, errCheckpoints)
+
+			require.Equal(t, tt.previousEpoch.Unwrap(), res.PreviousJustified.Epoch)
+			require.Equal(t, tt.finalizedEpoch.Unwrap(), res.CurrentJustified.Epoch)
+			require.Equal(t, res.CurrentJustified, res.Finalized)
+
+			if tt.finalizedIsHead {
+				headRoot, errRoot := b.BlockRootAtSlot(tt.stateSlot)
+				require.NoError(t, errRoot)
+				require.Equal(t, headRoot, res.Finalized.Root)
+			} else {
+				require.Equal(t, boundaryRoot(tt.finalizedEpoch), res.Finalized.Root)
+			}
+		})
+	}
+}
+
+// boundaryRoot returns a distinctive dummy block root for the boundary slot of the epoch.
+func boundaryRoot(epoch math.Epoch) common.Root {
+	return common.Root{0xaa, byte(epoch.Unwrap())}
+}
+
+func buildFinalityTestBackend(
+	t *testing.T, cs chain.Spec,
+) (*backend.Backend, storetypes.CommitMultiStore, *beacondb.KVStore) {
+	t.Helper()
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	b, err := backend.New(sb, cs, writeTestGenesis(t))
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{
+		cms:     cms,
+		kvStore: kvStore,
+		cs:      cs,
+	})
+	return b, cms, kvStore
+}
+
+// writeTestGenesis writes an empty app genesis in a temporary CometBFT home
+// and returns the matching CometBFT config.
+func writeTestGenesis(t *testing.T) *cmtcfg.Config {
+	t.Helper()
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	require.NoError(t, appGenesis.SaveAs(filepath.Join(tmpDir, "config", "genesis.json")))
+	return cmtCfg
+}
+
+func setupTestFinalityState(
+	t *testing.T,
+	cms storetypes.CommitMultiStore,
+	kvStore *beacondb.KVStore,
+	cs chain.Spec,
+	stateSlot math.Slot,
+) {
+	t.Helper()
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	setupStateDummyParts(t, cs, st, stateSlot)
+
+	// Record a distinctive block root at the boundary of the state's epoch.
+	epoch := cs.SlotToEpoch(stateSlot)
+	boundarySlot := epoch.Unwrap() * cs.SlotsPerEpoch()
+	require.NoError(t, st.UpdateBlockRootAtIndex(
+		boundarySlot%cs.SlotsPerHistoricalRoot(), boundaryRoot(epoch),
+	))
+
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// LightClientBlockAtSlot returns the light block of the beacon block at the given slot,
+// resolving an input slot of 0 to the latest slot, along with its fork version.
+func (b *Backend) LightClientBlockAtSlot(slot math.Slot) (*lightclient.LightBlock, common.Version, error) {
+	st, slot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, common.Version{}, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	fork, err := st.GetFork()
+	if err != nil {
+		return nil, common.Version{}, errors.Wrapf(err, "failed to get fork")
+	}
+
+	// The state root of the latest block header is only set in the next slot.
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, common.Version{}, errors.Wrapf(err, "failed to get latest block header")
+	}
+	header.SetStateRoot(st.HashTreeRoot())
+
+	// Beacon slots and CometBFT heights are the same.
+	cmtBlock, proof, err := b.node.LightBlock(
+		int64(slot.Unwrap()), // #nosec G115 -- not an issue in practice.
+		lightclient.BeaconBlockTxIndex,
+	)
+	if err != nil {
+		return nil, common.Version{}, errors.Wrapf(err, "failed to get CometBFT light block at height %d", slot)
+	}
+
+	return &lightclient.LightBlock{
+		Header:     header,
+		CometBFT:   cmtBlock,
+		BlockProof: proof,
+	}, fork.CurrentVersion, nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// cacheMetrics is a struct that contains metrics for the response cache.
+type cacheMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newCacheMetrics creates a new cacheMetrics.
+func newCacheMetrics(sink TelemetrySink) *cacheMetrics {
+	return &cacheMetrics{
+		sink: sink,
+	}
+}
+
+// markHit increments the counter of cache hits for the given route.
+func (cm *cacheMetrics) markHit(route string) {
+	cm.sink.IncrementCounter("beacon_kit.node_api.cache.hit", "route", route)
+}
+
+// markMiss increments the counter of cache misses for the given route.
+func (cm *cacheMetrics) markMiss(route string) {
+	cm.sink.IncrementCounter("beacon_kit.node_api.cache.miss", "route", route)
+}
+
+// setSize sets the gauge of the number of cached responses.
+func (cm *cacheMetrics) setSize(size int) {
+	cm.sink.SetGauge("beacon_kit.node_api.cache.size", int64(size))
+}
+
+// setBytes sets the gauge of the approximate size of the cached responses.
+func (cm *cacheMetrics) setBytes(bytes int) {
+	cm.sink.SetGauge("beacon_kit.node_api.cache.bytes", int64(bytes))
+}
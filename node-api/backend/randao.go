@@ -27,6 +27,12 @@ import (
 )
 
 func (b *Backend) RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error) {
+	return cached(b, routeRandao, slot, cacheParams(epoch), func() (common.Bytes32, error) {
+		return b.randaoAtEpoch(slot, epoch)
+	})
+}
+
+func (b *Backend) randaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error) {
 	// Get the state at the given slot.
 	st, resolvedSlot, err := b.StateAtSlot(slot)
 	if err != nil {
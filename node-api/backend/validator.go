@@ -79,6 +79,17 @@ func (f *validatorFilters) parseID(id string) {
 // given slot. It will then filter them by the provided ids and statuses.
 func (b *Backend) FilteredValidators(
 	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData, error) {
+	return cached(
+		b, routeValidators, slot, cacheParams(ids, statuses),
+		func() ([]*beacontypes.ValidatorData, error) {
+			return b.filteredValidators(slot, ids, statuses)
+		},
+	)
+}
+
+func (b *Backend) filteredValidators(
+	slot math.Slot, ids []string, statuses []string,
 ) ([]*beacontypes.ValidatorData, error) {
 	st, resolvedSlot, err := b.StateAtSlot(slot)
 	if err != nil {
@@ -196,6 +207,15 @@ func buildValidatorData(
 }
 
 func (b *Backend) ValidatorByID(slot math.Slot, id string) (*beacontypes.ValidatorData, error) {
+	return cached(
+		b, routeValidator, slot, cacheParams(id),
+		func() (*beacontypes.ValidatorData, error) {
+			return b.validatorByID(slot, id)
+		},
+	)
+}
+
+func (b *Backend) validatorByID(slot math.Slot, id string) (*beacontypes.ValidatorData, error) {
 	// Get the state at the given slot.
 	st, resolvedSlot, err := b.StateAtSlot(slot)
 	if err != nil {
@@ -237,7 +257,18 @@ func (b *Backend) ValidatorByID(slot math.Slot, id string) (*beacontypes.Validat
 	}, nil
 }
 
-func (b *Backend) ValidatorBalancesByIDs(slot math.Slot, ids []string) ([]*beacontypes.ValidatorBalanceData, error) {
+func (b *Backend) ValidatorBalancesByIDs(
+	slot math.Slot, ids []string,
+) ([]*beacontypes.ValidatorBalanceData, error) {
+	return cached(
+		b, routeValidatorBalances, slot, cacheParams(ids),
+		func() ([]*beacontypes.ValidatorBalanceData, error) {
+			return b.validatorBalancesByIDs(slot, ids)
+		},
+	)
+}
+
+func (b *Backend) validatorBalancesByIDs(slot math.Slot, ids []string) ([]*beacontypes.ValidatorBalanceData, error) {
 	// Get the state at the given slot.
 	st, _, err := b.StateAtSlot(slot)
 	if err != nil {
@@ -295,3 +326,73 @@ func (b *Backend) ValidatorBalancesByIDs(slot math.Slot, ids []string) ([]*beaco
 	}
 	return balances, nil
 }
+
+// ValidatorIdentitiesByIDs returns the index, pubkey and activation epoch of the validators
+// with the given ids in the state at the given slot. All validators are returned if no ids
+// are provided, while ids which do not match any validator are skipped.
+func (b *Backend) ValidatorIdentitiesByIDs(
+	slot math.Slot, ids []string,
+) ([]*beacontypes.ValidatorIdentityData, error) {
+	return cached(
+		b, routeValidatorIdentities, slot, cacheParams(ids),
+		func() ([]*beacontypes.ValidatorIdentityData, error) {
+			return b.validatorIdentitiesByIDs(slot, ids)
+		},
+	)
+}
+
+func (b *Backend) validatorIdentitiesByIDs(
+	slot math.Slot, ids []string,
+) ([]*beacontypes.ValidatorIdentityData, error) {
+	// Get the state at the given slot.
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+
+	// If no IDs provided, return all validator identities
+	if len(ids) == 0 {
+		validators, errInValidators := st.GetValidators()
+		if errInValidators != nil {
+			return nil, errors.Wrapf(errInValidators, "failed to get validators")
+		}
+		identities := make([]*beacontypes.ValidatorIdentityData, len(validators))
+		for i, validator := range validators {
+			identities[i] = &beacontypes.ValidatorIdentityData{
+				Index:           uint64(i), // #nosec:G115 // Safe as i comes from range loop
+				Pubkey:          validator.GetPubkey().String(),
+				ActivationEpoch: validator.GetActivationEpoch().Unwrap(),
+			}
+		}
+		return identities, nil
+	}
+
+	identities := make([]*beacontypes.ValidatorIdentityData, 0, len(ids))
+	for _, id := range ids {
+		index, errIndex := utils.ValidatorIndexByID(st, id)
+		switch {
+		case errIndex == nil:
+			// nothing to do, keep processing
+		case errors.Is(errIndex, collections.ErrNotFound):
+			// Unknown validators are skipped.
+			continue
+		default:
+			return nil, errors.Wrapf(errIndex, "failed to get validator index by id %s", id)
+		}
+
+		validator, errVal := st.ValidatorByIndex(index)
+		switch {
+		case errVal == nil:
+			identities = append(identities, &beacontypes.ValidatorIdentityData{
+				Index:           index.Unwrap(),
+				Pubkey:          validator.GetPubkey().String(),
+				ActivationEpoch: validator.GetActivationEpoch().Unwrap(),
+			})
+		case errors.Is(errVal, collections.ErrNotFound):
+			continue
+		default:
+			return nil, errors.Wrapf(errVal, "failed to get validator by index %d", index)
+		}
+	}
+	return identities, nil
+}
@@ -31,6 +31,8 @@ import (
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	// authToken is the token authenticated routes require.
+	authToken string
 }
 
 // New initializes a new API engine with the given Echo instance.
@@ -40,8 +42,9 @@ func New(e *echo.Echo) *Engine {
 	}
 }
 
-// NewDefaultEngine returns a new default Echo Engine instance.
-func NewDefaultEngine() *Engine {
+// NewDefaultEngine returns a new default Echo Engine instance, whose
+// authenticated routes require the given token.
+func NewDefaultEngine(authToken string) *Engine {
 	engine := echo.New()
 	engine.Use(middleware.CORSWithConfig(
 		middleware.DefaultCORSConfig,
@@ -50,7 +53,9 @@ func NewDefaultEngine() *Engine {
 		Validator: ConstructValidator(),
 	}
 	engine.HideBanner = true
-	return New(engine)
+	e := New(engine)
+	e.authToken = authToken
+	return e
 }
 
 // Run starts the Echo engine at the given address.
@@ -64,10 +69,19 @@ func (e *Engine) RegisterRoutes(hs *handlers.RouteSet, logger log.Logger) {
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
+		handler := responseMiddleware(route)
+		if route.Raw != nil {
+			handler = echo.WrapHandler(route.Raw)
+		}
+		var middlewares []echo.MiddlewareFunc
+		if route.Authenticated {
+			middlewares = append(middlewares, authMiddleware(e.authToken))
+		}
 		group.Add(
 			route.Method,
 			route.Path,
-			responseMiddleware(route),
+			handler,
+			middlewares...,
 		)
 	}
 }
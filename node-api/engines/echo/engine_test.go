@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatedRoutes(t *testing.T) {
+	t.Parallel()
+	raw := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("raw"))
+	})
+	// Routes are decorated as they are registered, each engine gets its own.
+	newRoutes := func() *handlers.RouteSet {
+		return handlers.NewRouteSet("",
+			&handlers.Route{
+				Method:  http.MethodGet,
+				Path:    "/public",
+				Handler: func(handlers.Context) (any, error) { return "public", nil },
+			},
+			&handlers.Route{
+				Method:        http.MethodGet,
+				Path:          "/private",
+				Raw:           raw,
+				Authenticated: true,
+			},
+		)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		path  string
+		auth  string
+		code  int
+		body  string
+	}{
+		{"public", "secret", "/public", "", http.StatusOK, "\"public\"\n"},
+		{"authorized", "secret", "/private", "Bearer secret", http.StatusOK, "raw"},
+		{"missing token", "secret", "/private", "", http.StatusUnauthorized, ""},
+		{"wrong token", "secret", "/private", "Bearer other", http.StatusUnauthorized, ""},
+		{"no token configured", "", "/private", "Bearer ", http.StatusUnauthorized, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			engine := echo.NewDefaultEngine(tc.token)
+			engine.RegisterRoutes(newRoutes(), noop.NewLogger[any]())
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+			require.Equal(t, tc.code, rec.Code)
+			if tc.body != "" {
+				require.Equal(t, tc.body, rec.Body.String())
+			}
+		})
+	}
+}
@@ -21,7 +21,9 @@
 package echo
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers"
@@ -45,6 +47,27 @@ func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 	}
 }
 
+// authMiddleware is a middleware that rejects the requests not bearing the
+// given token in their Authorization header. All requests are rejected if
+// the token is empty.
+func authMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c handlers.Context) error {
+			bearer, ok := strings.CutPrefix(
+				c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ",
+			)
+			if token == "" || !ok ||
+				subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				return c.JSON(http.StatusUnauthorized, ErrorResponse{
+					Code:    http.StatusUnauthorized,
+					Message: "missing or invalid auth token",
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
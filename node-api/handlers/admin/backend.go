@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/payload/cache"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// Backend resolves the state of the chain head.
+type Backend interface {
+	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+}
+
+// LogLevels controls the log levels of the node.
+type LogLevels interface {
+	// Levels returns the log level and the levels of the modules.
+	Levels() (string, map[string]string)
+	// SetLevels sets the log level and the levels of the modules, given as
+	// comma separated module=level pairs.
+	SetLevels(level string, moduleLevels string) error
+}
+
+// Chain is the blockchain service of the node.
+type Chain interface {
+	// PruneBlobs prunes the blob sidecars out of the availability window
+	// as of the given head slot.
+	PruneBlobs(headSlot math.Slot) (math.Slot, error)
+	// FailedDepositBlocks returns the EL blocks whose deposits failed to be
+	// fetched.
+	FailedDepositBlocks() []math.U64
+	// RefetchDeposits fetches and stores the deposits of the EL blocks in
+	// the given inclusive range.
+	RefetchDeposits(ctx context.Context, from, to math.U64) (int, error)
+}
+
+// PayloadIDs is the cache of the payload IDs requested by the node.
+type PayloadIDs interface {
+	Entries() []cache.PayloadIDCacheEntry
+}
+
+// ServiceRegistry reports the status of the services of the node.
+type ServiceRegistry interface {
+	Statuses() []service.ServiceStatus
+}
+
+// Consensus is the consensus service of the node.
+type Consensus interface {
+	LastBlockHeight() int64
+	RequestHalt(height int64) error
+}
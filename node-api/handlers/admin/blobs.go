@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	admintypes "github.com/berachain/beacon-kit/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// PruneBlobs prunes the blob sidecars that are out of the availability
+// window as of the chain head, as done when finalizing a block.
+func (h *Handler) PruneBlobs(handlers.Context) (any, error) {
+	_, headSlot, err := h.backend.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	prunedBefore, err := h.chain.PruneBlobs(headSlot)
+	if err != nil {
+		return nil, err
+	}
+	return admintypes.DataResponse{Data: &admintypes.PruneBlobsData{
+		HeadSlot:         strconv.FormatUint(headSlot.Unwrap(), 10),
+		PrunedBeforeSlot: strconv.FormatUint(prunedBefore.Unwrap(), 10),
+	}}, nil
+}
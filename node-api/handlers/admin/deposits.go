@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	admintypes "github.com/berachain/beacon-kit/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// maxRefetchBlocks is the maximum number of EL blocks whose deposits are
+// re-fetched at once, bounding the size of the logs query.
+const maxRefetchBlocks = 10_000
+
+// GetFailedDepositBlocks returns the EL blocks whose deposits failed to be
+// fetched and are pending a retry.
+func (h *Handler) GetFailedDepositBlocks(handlers.Context) (any, error) {
+	blocks := h.chain.FailedDepositBlocks()
+	data := make([]string, len(blocks))
+	for i, block := range blocks {
+		data[i] = strconv.FormatUint(block.Unwrap(), 10)
+	}
+	return admintypes.DataResponse{Data: data}, nil
+}
+
+// RefetchDeposits fetches and stores the deposits of a range of EL blocks
+// again.
+func (h *Handler) RefetchDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.RefetchDepositsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	from, err := math.U64FromString(req.FromBlock)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	to, err := math.U64FromString(req.ToBlock)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	if from > to || to-from >= maxRefetchBlocks {
+		return nil, types.ErrInvalidRequest
+	}
+
+	deposits, err := h.chain.RefetchDeposits(c.Request().Context(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return admintypes.DataResponse{Data: &admintypes.RefetchDepositsData{
+		FromBlock: req.FromBlock,
+		ToBlock:   req.ToBlock,
+		Deposits:  strconv.Itoa(deposits),
+	}}, nil
+}
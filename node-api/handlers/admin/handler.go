@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import "github.com/berachain/beacon-kit/node-api/handlers"
+
+// Handler is the handler for the admin endpoints, which operate the node at
+// runtime.
+type Handler struct {
+	*handlers.BaseHandler
+	// enabled determines if the admin endpoints are served.
+	enabled    bool
+	backend    Backend
+	logLevels  LogLevels
+	chain      Chain
+	payloadIDs PayloadIDs
+	// services and consensus are attached once the node is built, as they
+	// depend on the node API themselves.
+	services  ServiceRegistry
+	consensus Consensus
+}
+
+// NewHandler creates a new handler for the admin endpoints, which are only
+// served if enabled.
+func NewHandler(
+	enabled bool,
+	backend Backend,
+	logLevels LogLevels,
+	chain Chain,
+	payloadIDs PayloadIDs,
+) *Handler {
+	h := &Handler{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet(""),
+		),
+		enabled:    enabled,
+		backend:    backend,
+		logLevels:  logLevels,
+		chain:      chain,
+		payloadIDs: payloadIDs,
+	}
+	return h
+}
+
+// AttachNode sets the service registry and the consensus service of the node
+// on the handler.
+func (h *Handler) AttachNode(services ServiceRegistry, consensus Consensus) {
+	h.services = services
+	h.consensus = consensus
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	admintypes "github.com/berachain/beacon-kit/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetLogLevel returns the log level of the node and the levels of its
+// modules.
+func (h *Handler) GetLogLevel(handlers.Context) (any, error) {
+	level, moduleLevels := h.logLevels.Levels()
+	return admintypes.DataResponse{Data: &admintypes.LogLevelData{
+		Level:        level,
+		ModuleLevels: moduleLevels,
+	}}, nil
+}
+
+// SetLogLevel changes the log level of the node and the levels of its
+// modules.
+func (h *Handler) SetLogLevel(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.SetLogLevelRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.logLevels.SetLevels(req.Level, req.ModuleLevels); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	return h.GetLogLevel(c)
+}
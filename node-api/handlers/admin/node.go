@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	admintypes "github.com/berachain/beacon-kit/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetServices returns the status of the services of the node.
+func (h *Handler) GetServices(handlers.Context) (any, error) {
+	statuses := h.services.Statuses()
+	data := make([]*admintypes.ServiceData, len(statuses))
+	for i, status := range statuses {
+		data[i] = &admintypes.ServiceData{
+			Name:   status.Name,
+			Status: string(status.Status),
+		}
+		if status.Error != nil {
+			data[i].Error = status.Error.Error()
+		}
+	}
+	return admintypes.DataResponse{Data: data}, nil
+}
+
+// Halt requests the node to shut down gracefully once the block at the
+// given height has been committed.
+func (h *Handler) Halt(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.HaltRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	height, err := strconv.ParseInt(req.Height, 10, 64)
+	if err != nil || height <= h.consensus.LastBlockHeight() {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = h.consensus.RequestHalt(height); err != nil {
+		return nil, err
+	}
+	return admintypes.DataResponse{Data: &admintypes.HaltData{
+		Height: req.Height,
+	}}, nil
+}
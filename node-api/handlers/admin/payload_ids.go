@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	admintypes "github.com/berachain/beacon-kit/node-api/handlers/admin/types"
+)
+
+// GetPayloadIDs returns the payload IDs the node has requested from the
+// execution client and not yet retrieved.
+func (h *Handler) GetPayloadIDs(handlers.Context) (any, error) {
+	entries := h.payloadIDs.Entries()
+	data := make([]*admintypes.PayloadIDData, len(entries))
+	for i, entry := range entries {
+		data[i] = &admintypes.PayloadIDData{
+			Slot:         strconv.FormatUint(entry.Slot.Unwrap(), 10),
+			ParentRoot:   entry.ParentRoot,
+			PayloadID:    entry.PayloadID.String(),
+			ForkVersion:  entry.ForkVersion.String(),
+			FeeRecipient: entry.FeeRecipient,
+		}
+	}
+	return admintypes.DataResponse{Data: data}, nil
+}
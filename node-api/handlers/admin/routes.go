@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+)
+
+func (h *Handler) RegisterRoutes(logger log.Logger) {
+	h.SetLogger(logger)
+	if !h.enabled {
+		return
+	}
+	h.BaseHandler.AddRoutes([]*handlers.Route{
+		{
+			Method:        http.MethodGet,
+			Path:          "/admin/v1/log_level",
+			Handler:       h.GetLogLevel,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPut,
+			Path:          "/admin/v1/log_level",
+			Handler:       h.SetLogLevel,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/admin/v1/blobs/prune",
+			Handler:       h.PruneBlobs,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/admin/v1/payload_ids",
+			Handler:       h.GetPayloadIDs,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/admin/v1/deposits/failed_blocks",
+			Handler:       h.GetFailedDepositBlocks,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/admin/v1/deposits/refetch",
+			Handler:       h.RefetchDeposits,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/admin/v1/services",
+			Handler:       h.GetServices,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/admin/v1/halt",
+			Handler:       h.Halt,
+			Authenticated: true,
+		},
+	})
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// SetLogLevelRequest is the body of the PUT /admin/v1/log_level endpoint.
+type SetLogLevelRequest struct {
+	Level string `json:"level" validate:"required"`
+	// ModuleLevels are comma separated module=level pairs, replacing the
+	// current levels of the modules.
+	ModuleLevels string `json:"module_levels"`
+}
+
+// RefetchDepositsRequest is the body of the /admin/v1/deposits/refetch
+// endpoint.
+type RefetchDepositsRequest struct {
+	FromBlock string `json:"from_block" validate:"required,numeric"`
+	ToBlock   string `json:"to_block"   validate:"required,numeric"`
+}
+
+// HaltRequest is the body of the /admin/v1/halt endpoint.
+type HaltRequest struct {
+	Height string `json:"height" validate:"required,numeric"`
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// DataResponse is the response of the admin endpoints.
+type DataResponse struct {
+	Data any `json:"data"`
+}
+
+// LogLevelData is the log level of the node.
+type LogLevelData struct {
+	Level        string            `json:"level"`
+	ModuleLevels map[string]string `json:"module_levels"`
+}
+
+// PruneBlobsData is the result of a blob store prune.
+type PruneBlobsData struct {
+	HeadSlot string `json:"head_slot"`
+	// PrunedBeforeSlot is the slot before which the blob sidecars have been
+	// pruned.
+	PrunedBeforeSlot string `json:"pruned_before_slot"`
+}
+
+// PayloadIDData is a payload ID requested by the node.
+type PayloadIDData struct {
+	Slot         string                  `json:"slot"`
+	ParentRoot   common.Root             `json:"parent_root"`
+	PayloadID    string                  `json:"payload_id"`
+	ForkVersion  string                  `json:"fork_version"`
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+}
+
+// RefetchDepositsData is the result of a deposit re-fetch.
+type RefetchDepositsData struct {
+	FromBlock string `json:"from_block"`
+	ToBlock   string `json:"to_block"`
+	Deposits  string `json:"deposits"`
+}
+
+// ServiceData is the status of a service of the node.
+type ServiceData struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HaltData is the height the node halts at.
+type HaltData struct {
+	Height string `json:"height"`
+}
@@ -21,6 +21,7 @@
 package beacon
 
 import (
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -33,6 +34,9 @@ type Backend interface {
 	GenesisBackend
 	BlobBackend
 	BlockBackend
+	DepositBackend
+	FinalityBackend
+	LightClientBackend
 	RandaoBackend
 	StateBackend
 	ValidatorBackend
@@ -49,6 +53,19 @@ type GenesisBackend interface {
 	GenesisTime() (math.U64, error)
 }
 
+type DepositBackend interface {
+	DepositSnapshot() *types.DepositSnapshotData
+	PendingDepositsAtState(st *statedb.StateDB, limit uint64) ([]*types.PendingDepositData, error)
+}
+
+type FinalityBackend interface {
+	FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error)
+}
+
+type LightClientBackend interface {
+	LightClientBlockAtSlot(slot math.Slot) (*lightclient.LightBlock, common.Version, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
@@ -84,4 +101,8 @@ type ValidatorBackend interface {
 		slot math.Slot,
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
+	ValidatorIdentitiesByIDs(
+		slot math.Slot,
+		ids []string,
+	) ([]*types.ValidatorIdentityData, error)
 }
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// MaxPendingDeposits is the maximum number of pending deposits returned by a
+// single request.
+const MaxPendingDeposits = 1024
+
+func (h *Handler) GetDepositSnapshot(handlers.Context) (any, error) {
+	return beacontypes.DepositSnapshotResponse{
+		Data: h.backend.DepositSnapshot(),
+	}, nil
+}
+
+func (h *Handler) GetPendingDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetPendingDepositsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	switch {
+	case err == nil:
+		// No error, continue
+	case errors.Is(err, utils.ErrNoSlotForStateRoot):
+		return nil, errors.Wrapf(types.ErrNotFound, "state %s", req.StateID)
+	default:
+		return nil, err
+	}
+
+	st, _, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fork version.
+	forkVersion, err := st.GetFork()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the deposits not yet included in the state from the deposit store.
+	deposits, err := h.backend.PendingDepositsAtState(st, MaxPendingDeposits)
+	if err != nil {
+		return nil, err
+	}
+
+	return beacontypes.NewPendingDepositsResponse(
+		forkVersion.CurrentVersion,
+		deposits,
+	), nil
+}
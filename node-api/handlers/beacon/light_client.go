@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// MaxRequestLightClientUpdates is the maximum number of light client updates
+// returned by a single request.
+const MaxRequestLightClientUpdates = 128
+
+func (h *Handler) GetLightClientBootstrap(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientBootstrapRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	root, err := common.NewRootFromHex(req.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := h.backend.GetSlotByBlockRoot(root)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrNotFound, "block root %s: %v", root, err)
+	}
+
+	lightBlock, forkVersion, err := h.backend.LightClientBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewLightClientResponse(forkVersion, lightBlock), nil
+}
+
+func (h *Handler) GetLightClientUpdates(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientUpdatesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	start, err := math.U64FromString(req.StartPeriod)
+	if err != nil {
+		return nil, err
+	}
+	count, err := math.U64FromString(req.Count)
+	if err != nil {
+		return nil, err
+	}
+	count = min(count, MaxRequestLightClientUpdates)
+	// Slot 0 resolves to the head in the backend and there is no light block
+	// for genesis, so updates start from the first committed block.
+	start = max(start, 1)
+
+	// Updates are only available up to the head.
+	_, head, err := h.backend.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]beacontypes.LightClientResponse, 0, count)
+	for slot := start; slot < start+count && slot <= head; slot++ {
+		lightBlock, forkVersion, errUpdate := h.backend.LightClientBlockAtSlot(slot)
+		if errUpdate != nil {
+			return nil, errUpdate
+		}
+		updates = append(updates, beacontypes.NewLightClientResponse(forkVersion, lightBlock))
+	}
+	return updates, nil
+}
+
+func (h *Handler) GetLightClientFinalityUpdate(handlers.Context) (any, error) {
+	// Every committed block is final, hence the finality update is the one of the head.
+	lightBlock, forkVersion, err := h.backend.LightClientBlockAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewLightClientResponse(forkVersion, lightBlock), nil
+}
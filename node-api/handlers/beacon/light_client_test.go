@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/stretchr/testify/require"
+)
+
+// testLightClientBackend serves light blocks up to head and records the
+// requested slots. Methods not overridden panic through the nil embedding.
+type testLightClientBackend struct {
+	beacon.Backend
+
+	head math.Slot
+
+	mu        sync.Mutex
+	requested []math.Slot
+}
+
+func (b *testLightClientBackend) StateAtSlot(math.Slot) (*statedb.StateDB, math.Slot, error) {
+	return nil, b.head, nil
+}
+
+func (b *testLightClientBackend) LightClientBlockAtSlot(
+	slot math.Slot,
+) (*lightclient.LightBlock, common.Version, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.requested = append(b.requested, slot)
+	return nil, version.Deneb(), nil
+}
+
+func TestGetLightClientUpdatesSkipsSlotZero(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		query     string
+		requested []math.Slot
+	}{
+		{"start period zero", "start_period=0&count=3", []math.Slot{1, 2, 3}},
+		{"start period one", "start_period=1&count=2", []math.Slot{1, 2}},
+		{"capped at head", "start_period=4&count=3", []math.Slot{4, 5}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			backend := &testLightClientBackend{head: 5}
+			h := beacon.NewHandler(backend)
+			h.RegisterRoutes(noop.NewLogger[any]())
+			engine := echo.NewDefaultEngine("")
+			engine.RegisterRoutes(h.RouteSet(), noop.NewLogger[any]())
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/eth/v1/beacon/light_client/updates?"+tc.query,
+				nil,
+			)
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			require.Equal(t, tc.requested, backend.requested)
+		})
+	}
+}
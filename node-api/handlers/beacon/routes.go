@@ -51,7 +51,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/finality_checkpoints",
-			Handler: h.NotImplemented,
+			Handler: h.GetStateFinalityCheckpoints,
 		},
 		{
 			Method:  http.MethodGet,
@@ -81,7 +81,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_identities",
-			Handler: h.NotImplemented,
+			Handler: h.PostStateValidatorIdentities,
 		},
 		{
 			Method:  http.MethodGet,
@@ -101,7 +101,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/pending_deposits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPendingDeposits,
 		},
 		{
 			Method:  http.MethodGet,
@@ -171,7 +171,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/deposit_snapshot",
-			Handler: h.Deprecated,
+			Handler: h.GetDepositSnapshot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -191,17 +191,17 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
 		},
 		{
 			Method:  http.MethodGet,
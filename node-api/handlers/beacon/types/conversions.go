@@ -76,6 +76,36 @@ func ValidatorFromConsensus(v *ctypes.Validator) *Validator {
 	}
 }
 
+func PendingDepositFromConsensus(d *ctypes.Deposit) *PendingDepositData {
+	return &PendingDepositData{
+		Pubkey:                d.GetPubkey().String(),
+		WithdrawalCredentials: d.GetWithdrawalCredentials().String(),
+		Amount:                d.GetAmount().Unwrap(),
+		Signature:             d.Signature.String(),
+		Index:                 d.GetIndex().Unwrap(),
+	}
+}
+
+func DepositSnapshotFromConsensus(s *ctypes.DepositTreeSnapshot) *DepositSnapshotData {
+	return &DepositSnapshotData{
+		Finalized:            s.Finalized,
+		DepositRoot:          s.DepositRoot,
+		DepositCount:         s.DepositCount.Unwrap(),
+		ExecutionBlockHash:   s.ExecutionBlockHash,
+		ExecutionBlockHeight: s.ExecutionBlockHeight.Unwrap(),
+	}
+}
+
+func DepositSnapshotToConsensus(s *DepositSnapshotData) *ctypes.DepositTreeSnapshot {
+	return &ctypes.DepositTreeSnapshot{
+		Finalized:            s.Finalized,
+		DepositRoot:          s.DepositRoot,
+		DepositCount:         math.U64(s.DepositCount),
+		ExecutionBlockHash:   s.ExecutionBlockHash,
+		ExecutionBlockHeight: math.U64(s.ExecutionBlockHeight),
+	}
+}
+
 // useful in UTs
 func ValidatorToConsensus(v *Validator) (*ctypes.Validator, error) {
 	pk, err := parser.ConvertPubkey(v.PublicKey)
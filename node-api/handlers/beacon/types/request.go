@@ -47,6 +47,10 @@ type GetPendingPartialWithdrawalsRequest struct {
 	types.StateIDRequest
 }
 
+type GetPendingDepositsRequest struct {
+	types.StateIDRequest
+}
+
 type GetStateValidatorsRequest struct {
 	types.StateIDRequest
 	IDs      []string `query:"id"     validate:"dive,validator_id"`
@@ -74,6 +78,11 @@ type PostValidatorBalancesRequest struct {
 	IDs []string `json:"-" validate:"dive,validator_id"`
 }
 
+type PostValidatorIdentitiesRequest struct {
+	types.StateIDRequest
+	IDs []string `json:"-" validate:"dive,validator_id"`
+}
+
 type GetStateCommitteesRequest struct {
 	types.StateIDRequest
 	EpochOptionalRequest
@@ -143,6 +152,18 @@ type PostRewardsSyncCommitteeRequest struct {
 
 type GetDepositTreeSnapshotRequest struct{}
 
+type GetLightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required,hex"`
+}
+
+// GetLightClientUpdatesRequest requests the light client updates of count
+// consecutive heights, starting from start_period. Since every CometBFT block
+// is final, a period is a single height.
+type GetLightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,slot"`
+	Count       string `query:"count"        validate:"required,slot"`
+}
+
 type GetBlockRewardsRequest struct {
 	types.BlockIDRequest
 }
@@ -21,6 +21,7 @@
 package types
 
 import (
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/version"
 )
@@ -87,10 +88,42 @@ type GenesisResponse struct {
 	Data GenesisData `json:"data"`
 }
 
+// DepositSnapshotResponse is handled with this explicit response type since
+// "finalized" and "execution_optimistic" are not part of the return value.
+//
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getDepositSnapshot
+type DepositSnapshotResponse struct {
+	Data *DepositSnapshotData `json:"data"`
+}
+
+// DepositSnapshotData is the spec representation of an EIP-4881 deposit tree snapshot.
+type DepositSnapshotData struct {
+	Finalized            []common.Root        `json:"finalized"`
+	DepositRoot          common.Root          `json:"deposit_root"`
+	DepositCount         uint64               `json:"deposit_count,string"`
+	ExecutionBlockHash   common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64               `json:"execution_block_height,string"`
+}
+
 type RootData struct {
 	Root common.Root `json:"root"`
 }
 
+// Checkpoint is the spec representation of a (epoch, root) checkpoint.
+type Checkpoint struct {
+	Epoch uint64      `json:"epoch,string"`
+	Root  common.Root `json:"root"`
+}
+
+// FinalityCheckpointsData is the response data of the finality checkpoints endpoint.
+//
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getStateFinalityCheckpoints
+type FinalityCheckpointsData struct {
+	PreviousJustified *Checkpoint `json:"previous_justified"`
+	CurrentJustified  *Checkpoint `json:"current_justified"`
+	Finalized         *Checkpoint `json:"finalized"`
+}
+
 type ValidatorData struct {
 	ValidatorBalanceData
 	Status    string     `json:"status"`
@@ -102,6 +135,16 @@ type ValidatorBalanceData struct {
 	Balance uint64 `json:"balance,string"`
 }
 
+// ValidatorIdentityData is the compact validator representation returned by
+// the validator identities endpoint.
+//
+// https://ethereum.github.io/beacon-APIs/#/Beacon/postStateValidatorIdentities
+type ValidatorIdentityData struct {
+	Index           uint64 `json:"index,string"`
+	Pubkey          string `json:"pubkey"`
+	ActivationEpoch uint64 `json:"activation_epoch,string"`
+}
+
 // Validator is the spec representation of the struct.
 type Validator struct {
 	PublicKey                  string `json:"pubkey"`
@@ -167,3 +210,53 @@ func NewPendingPartialWithdrawalsResponse(
 		GenericResponse: NewResponse(withdrawals),
 	}
 }
+
+// PendingDepositsResponse has a version field to indicate the fork version.
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getPendingDeposits
+type PendingDepositsResponse struct {
+	Version string `json:"version"`
+	GenericResponse
+}
+
+// PendingDepositData is a deposit observed on the execution layer which has
+// not been included in the beacon state yet.
+type PendingDepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount,string"`
+	Signature             string `json:"signature"`
+	Index                 uint64 `json:"index,string"`
+}
+
+// NewPendingDepositsResponse creates a typed response with PendingDeposit data
+func NewPendingDepositsResponse(
+	forkVersion common.Version,
+	deposits []*PendingDepositData,
+) PendingDepositsResponse {
+	return PendingDepositsResponse{
+		// Version is the name of the fork version.
+		Version:         version.Name(forkVersion),
+		GenericResponse: NewResponse(deposits),
+	}
+}
+
+// LightClientResponse is handled with this explicit response type since
+// "finalized" and "execution_optimistic" are not part of the return value.
+//
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientBootstrap
+type LightClientResponse struct {
+	Version string                  `json:"version"`
+	Data    *lightclient.LightBlock `json:"data"`
+}
+
+// NewLightClientResponse creates a typed response with a light block.
+func NewLightClientResponse(
+	forkVersion common.Version,
+	lightBlock *lightclient.LightBlock,
+) LightClientResponse {
+	return LightClientResponse{
+		// Version is the name of the fork version.
+		Version: version.Name(forkVersion),
+		Data:    lightBlock,
+	}
+}
@@ -175,3 +175,40 @@ func (h *Handler) PostStateValidatorBalances(c handlers.Context) (any, error) {
 	}
 	return beacontypes.NewResponse(balances), nil
 }
+
+func (h *Handler) PostStateValidatorIdentities(c handlers.Context) (any, error) {
+	var ids []string
+	if err := c.Bind(&ids); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	// Get state_id from URL path parameter
+	req := beacontypes.PostValidatorIdentitiesRequest{
+		StateIDRequest: types.StateIDRequest{StateID: c.Param("state_id")},
+		IDs:            ids,
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	switch {
+	case err == nil:
+		// No error, continue
+	case errors.Is(err, utils.ErrNoSlotForStateRoot):
+		return &handlers.HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "State not found",
+		}, nil
+	default:
+		return nil, err
+	}
+	identities, err := h.backend.ValidatorIdentitiesByIDs(
+		slot,
+		req.IDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(identities), nil
+}
@@ -20,17 +20,43 @@
 
 package node
 
-import "github.com/berachain/beacon-kit/node-api/handlers"
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-core/services/elsync"
+)
+
+// SyncStatus provides the sync status of the execution client.
+type SyncStatus interface {
+	Status() elsync.Status
+}
+
+// Consensus provides the sync status of CometBFT.
+type Consensus interface {
+	// IsCatchingUp reports whether CometBFT is block syncing or state syncing.
+	IsCatchingUp() bool
+	// LastBlockHeight returns the latest height committed by the node.
+	LastBlockHeight() int64
+	// NetworkHeight returns the latest height committed by the network.
+	NetworkHeight() int64
+}
 
 type Handler struct {
 	*handlers.BaseHandler
+	syncStatus SyncStatus
+	consensus  Consensus
 }
 
-func NewHandler() *Handler {
+func NewHandler(syncStatus SyncStatus) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		syncStatus: syncStatus,
 	}
 	return h
 }
+
+// AttachConsensus sets the consensus service of the node on the handler.
+func (h *Handler) AttachConsensus(consensus Consensus) {
+	h.consensus = consensus
+}
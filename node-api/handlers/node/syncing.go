@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+)
+
+// Syncing returns the sync status of the node. The beacon chain is kept in
+// sync by CometBFT, so the node is syncing while CometBFT catches up with the
+// network, and its sync distance is how many heights the network is ahead. The
+// node reports itself as optimistic whenever the execution client has not
+// verified the head, i.e. it is offline, syncing or behind the latest
+// committed payload.
+func (h *Handler) Syncing(handlers.Context) (any, error) {
+	status := h.syncStatus.Status()
+	isSyncing, distance := h.consensusSyncStatus()
+	return types.SyncingResponse{Data: &types.SyncingData{
+		HeadSlot:     strconv.FormatUint(status.HeadSlot.Unwrap(), 10),
+		SyncDistance: strconv.FormatInt(distance, 10),
+		IsSyncing:    isSyncing,
+		// Until the execution client has been polled its view of the head
+		// is unknown.
+		IsOptimistic: status.UpdatedAt.IsZero() || status.IsOptimistic(),
+		ELOffline:    status.ELOffline,
+	}}, nil
+}
+
+// consensusSyncStatus returns whether CometBFT is catching up with the
+// network and how many heights the network is ahead of the node. The node is
+// considered synced until the consensus service is attached.
+func (h *Handler) consensusSyncStatus() (bool, int64) {
+	if h.consensus == nil {
+		return false, 0
+	}
+	distance := max(h.consensus.NetworkHeight()-h.consensus.LastBlockHeight(), 0)
+	return h.consensus.IsCatchingUp(), distance
+}
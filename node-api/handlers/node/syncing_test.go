@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-core/services/elsync"
+	"github.com/stretchr/testify/require"
+)
+
+// testSyncStatus reports a synced execution client at head slot 10.
+type testSyncStatus struct{}
+
+func (testSyncStatus) Status() elsync.Status {
+	return elsync.Status{HeadSlot: 10, PayloadNumber: 10, ELHead: 10, UpdatedAt: time.Now()}
+}
+
+// testConsensus reports a fixed CometBFT sync status.
+type testConsensus struct {
+	catchingUp    bool
+	lastHeight    int64
+	networkHeight int64
+}
+
+func (c testConsensus) IsCatchingUp() bool     { return c.catchingUp }
+func (c testConsensus) LastBlockHeight() int64 { return c.lastHeight }
+func (c testConsensus) NetworkHeight() int64   { return c.networkHeight }
+
+func TestSyncing(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		consensus *testConsensus
+		distance  string
+		isSyncing bool
+	}{
+		{"not attached", nil, "0", false},
+		{"synced", &testConsensus{lastHeight: 10, networkHeight: 10}, "0", false},
+		{"catching up", &testConsensus{catchingUp: true, lastHeight: 10, networkHeight: 25}, "15", true},
+		{"peers behind", &testConsensus{lastHeight: 10, networkHeight: 8}, "0", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			h := node.NewHandler(testSyncStatus{})
+			if tc.consensus != nil {
+				h.AttachConsensus(*tc.consensus)
+			}
+			h.RegisterRoutes(noop.NewLogger[any]())
+			engine := echo.NewDefaultEngine("")
+			engine.RegisterRoutes(h.RouteSet(), noop.NewLogger[any]())
+
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/syncing", nil)
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var res types.SyncingResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, "10", res.Data.HeadSlot)
+			require.Equal(t, tc.distance, res.Data.SyncDistance)
+			require.Equal(t, tc.isSyncing, res.Data.IsSyncing)
+			require.False(t, res.Data.IsOptimistic)
+		})
+	}
+}
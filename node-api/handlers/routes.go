@@ -21,6 +21,8 @@
 package handlers
 
 import (
+	"net/http"
+
 	"github.com/berachain/beacon-kit/log"
 )
 
@@ -29,12 +31,21 @@ type Route struct {
 	Method  string
 	Path    string
 	Handler handlerFn
+	// Raw, if set, serves the route in place of Handler, for responses that
+	// are not JSON.
+	Raw http.Handler
+	// Authenticated restricts the route to the requests bearing the auth
+	// token of the node API.
+	Authenticated bool
 }
 
 // DecorateWithLogs adds logging to the route's handler function as soon as
 // a request is received and when a response is ready.
 func (r *Route) DecorateWithLogs(logger log.Logger) {
 	handler := r.Handler
+	if handler == nil {
+		return
+	}
 	r.Handler = func(ctx Context) (any, error) {
 		logger.Info("received request", "method", r.Method, "path", r.Path)
 		res, err := handler(ctx)
@@ -20,17 +20,28 @@
 
 package validator
 
-import "github.com/berachain/beacon-kit/node-api/handlers"
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+)
+
+// FeeRecipients registers the fee recipients of validators.
+type FeeRecipients interface {
+	// Prepare registers the fee recipients of the given validators.
+	Prepare(preparations []feerecipient.Preparation) error
+}
 
 type Handler struct {
 	*handlers.BaseHandler
+	feeRecipients FeeRecipients
 }
 
-func NewHandler() *Handler {
+func NewHandler(feeRecipients FeeRecipients) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		feeRecipients: feeRecipients,
 	}
 	return h
 }
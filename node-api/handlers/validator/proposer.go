@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// PrepareBeaconProposer registers the fee recipients of the validators
+// proposing through this node. The route is authenticated, as registered fee
+// recipients override the configured ones across restarts.
+func (h *Handler) PrepareBeaconProposer(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.PrepareBeaconProposerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preparations := make([]feerecipient.Preparation, len(req))
+	for i, p := range req {
+		index, errIndex := math.U64FromString(p.ValidatorIndex)
+		if errIndex != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		var addr common.ExecutionAddress
+		if err = addr.UnmarshalText([]byte(p.FeeRecipient)); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		preparations[i] = feerecipient.Preparation{
+			ValidatorIndex: index,
+			FeeRecipient:   addr,
+		}
+	}
+	return nil, h.feeRecipients.Prepare(preparations)
+}
@@ -103,7 +103,10 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/validator/prepare_beacon_proposer",
-			Handler: h.NotImplemented,
+			Handler: h.PrepareBeaconProposer,
+			// Registered fee recipients are persisted, so that only the
+			// validator clients holding the auth token may change them.
+			Authenticated: true,
 		},
 		{
 			Method:  http.MethodPost,
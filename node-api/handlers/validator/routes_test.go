@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/stretchr/testify/require"
+)
+
+// testFeeRecipients records the registered fee recipients.
+type testFeeRecipients struct {
+	prepared []feerecipient.Preparation
+}
+
+func (f *testFeeRecipients) Prepare(preparations []feerecipient.Preparation) error {
+	f.prepared = append(f.prepared, preparations...)
+	return nil
+}
+
+func TestPrepareBeaconProposerRequiresAuth(t *testing.T) {
+	t.Parallel()
+	const body = `[{"validator_index":"1",` +
+		`"fee_recipient":"0xabcf8e0d4e9587369b2301d0790347320302cc09"}]`
+
+	tests := []struct {
+		name     string
+		auth     string
+		code     int
+		prepared int
+	}{
+		{"missing token", "", http.StatusUnauthorized, 0},
+		{"wrong token", "Bearer other", http.StatusUnauthorized, 0},
+		{"authorized", "Bearer secret", http.StatusOK, 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			feeRecipients := &testFeeRecipients{}
+			h := validator.NewHandler(feeRecipients)
+			h.RegisterRoutes(noop.NewLogger[any]())
+			engine := echo.NewDefaultEngine("secret")
+			engine.RegisterRoutes(h.RouteSet(), noop.NewLogger[any]())
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/eth/v1/validator/prepare_beacon_proposer",
+				strings.NewReader(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+			require.Equal(t, tc.code, rec.Code)
+			require.Len(t, feeRecipients.prepared, tc.prepared)
+		})
+	}
+}
@@ -21,7 +21,9 @@
 package server
 
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress       = "127.0.0.1:3500"
+	defaultCacheSize     = 4096
+	defaultCacheMaxBytes = 64 << 20
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +34,29 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// CacheSize is the maximum number of cached responses, 0 disables caching.
+	CacheSize int `mapstructure:"cache-size"`
+	// CacheMaxBytes is the approximate maximum size in bytes of the cached
+	// responses. Responses larger than it are not cached.
+	CacheMaxBytes int `mapstructure:"cache-max-bytes"`
+	// AuthToken is the bearer token required by the authenticated endpoints.
+	// The authenticated endpoints reject every request if it is empty.
+	AuthToken string `mapstructure:"auth-token"`
+	// Pprof is the flag to serve the authenticated pprof endpoints under
+	// /debug/pprof/.
+	Pprof bool `mapstructure:"pprof"`
+	// Admin is the flag to serve the authenticated admin endpoints under
+	// /admin/v1/, which operate the node at runtime.
+	Admin bool `mapstructure:"admin"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:       false,
+		Address:       defaultAddress,
+		Logging:       false,
+		CacheSize:     defaultCacheSize,
+		CacheMaxBytes: defaultCacheMaxBytes,
 	}
 }
@@ -79,6 +79,15 @@ func DefaultServiceOptions(
 			true,
 		),
 		cometbft.SetChainID(chainID),
+		cometbft.SetHaltHeight(
+			cast.ToUint64(appOpts.Get(server.FlagHaltHeight)),
+		),
+		cometbft.SetHaltTime(
+			cast.ToUint64(appOpts.Get(server.FlagHaltTime)),
+		),
+		cometbft.SetHaltMarker(filepath.Join(
+			cast.ToString(appOpts.Get(flags.FlagHome)), "data", "halt.json",
+		)),
 	}
 }
 
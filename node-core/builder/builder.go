@@ -28,6 +28,10 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	adminapi "github.com/berachain/beacon-kit/node-api/handlers/admin"
+	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
 	"github.com/berachain/beacon-kit/node-core/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -67,9 +71,13 @@ func (nb *NodeBuilder) Build(
 		apiBackend interface {
 			AttachQueryBackend(types.ConsensusService)
 		}
+		adminAPI   *adminapi.Handler
+		nodeAPI    *nodeapi.Handler
+		monitor    *validatormonitor.Monitor
 		beaconNode types.Node
 		cmtService types.ConsensusService
 		config     *config.Config
+		registry   *service.Registry
 	)
 
 	chainSpec, err := spec.Create(appOpts)
@@ -92,9 +100,13 @@ func (nb *NodeBuilder) Build(
 			),
 		),
 		&apiBackend,
+		&adminAPI,
+		&nodeAPI,
+		&monitor,
 		&beaconNode,
 		&cmtService,
 		&config,
+		&registry,
 	); err != nil {
 		panic(err)
 	}
@@ -107,5 +119,8 @@ func (nb *NodeBuilder) Build(
 
 	logger.WithConfig(config.GetLogger())
 	apiBackend.AttachQueryBackend(cmtService)
+	adminAPI.AttachNode(registry, cmtService)
+	nodeAPI.AttachConsensus(cmtService)
+	monitor.AttachProposerSchedule(cmtService)
 	return beaconNode
 }
@@ -30,21 +30,24 @@ import (
 	"github.com/berachain/beacon-kit/node-api/engines/echo"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	cmtcfg "github.com/cometbft/cometbft/config"
 )
 
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngine(cfg *config.Config) *echo.Engine {
+	return echo.NewDefaultEngine(cfg.NodeAPI.AuthToken)
 }
 
 type NodeAPIBackendInput struct {
 	depinject.In
 
 	ChainSpec      chain.Spec
+	Config         *config.Config
 	StorageBackend *storage.Backend
 	CometConfig    *cmtcfg.Config
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 func ProvideNodeAPIBackend(
@@ -54,6 +57,9 @@ func ProvideNodeAPIBackend(
 		in.StorageBackend,
 		in.ChainSpec,
 		in.CometConfig,
+		backend.WithResponseCache(
+			in.Config.NodeAPI.CacheSize, in.Config.NodeAPI.CacheMaxBytes, in.TelemetrySink,
+		),
 	)
 }
 
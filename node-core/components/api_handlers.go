@@ -22,39 +22,64 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	adminapi "github.com/berachain/beacon-kit/node-api/handlers/admin"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/node-api/handlers/debug"
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
+	profilingapi "github.com/berachain/beacon-kit/node-api/handlers/profiling"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-core/services/elsync"
+	"github.com/berachain/beacon-kit/payload/cache"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
 )
 
 type NodeAPIHandlersInput struct {
 	depinject.In
-	BeaconAPIHandler  *beaconapi.Handler
-	BuilderAPIHandler *builderapi.Handler
-	ConfigAPIHandler  *configapi.Handler
-	DebugAPIHandler   *debugapi.Handler
-	EventsAPIHandler  *eventsapi.Handler
-	NodeAPIHandler    *nodeapi.Handler
-	ProofAPIHandler   *proofapi.Handler
+	AdminAPIHandler     *adminapi.Handler
+	BeaconAPIHandler    *beaconapi.Handler
+	BuilderAPIHandler   *builderapi.Handler
+	ConfigAPIHandler    *configapi.Handler
+	DebugAPIHandler     *debugapi.Handler
+	EventsAPIHandler    *eventsapi.Handler
+	NodeAPIHandler      *nodeapi.Handler
+	ProfilingAPIHandler *profilingapi.Handler
+	ProofAPIHandler     *proofapi.Handler
+	ValidatorAPIHandler *validatorapi.Handler
 }
 
 func ProvideNodeAPIHandlers(in NodeAPIHandlersInput) []handlers.Handlers {
 	return []handlers.Handlers{
+		in.AdminAPIHandler,
 		in.BeaconAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
 		in.DebugAPIHandler,
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
+		in.ProfilingAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
+func ProvideNodeAPIAdminHandler(
+	cfg *config.Config,
+	b NodeAPIBackend,
+	logger *phuslu.Logger,
+	chain *blockchain.Service,
+	payloadIDs *cache.PayloadIDCache,
+) *adminapi.Handler {
+	return adminapi.NewHandler(cfg.NodeAPI.Admin, b, logger, chain, payloadIDs)
+}
+
 func ProvideNodeAPIBeaconHandler(b NodeAPIBackend) *beaconapi.Handler {
 	return beaconapi.NewHandler(b)
 }
@@ -75,10 +100,18 @@ func ProvideNodeAPIEventsHandler() *eventsapi.Handler {
 	return eventsapi.NewHandler()
 }
 
-func ProvideNodeAPINodeHandler() *nodeapi.Handler {
-	return nodeapi.NewHandler()
+func ProvideNodeAPINodeHandler(syncMonitor *elsync.Monitor) *nodeapi.Handler {
+	return nodeapi.NewHandler(syncMonitor)
+}
+
+func ProvideNodeAPIProfilingHandler(cfg *config.Config) *profilingapi.Handler {
+	return profilingapi.NewHandler(cfg.NodeAPI.Pprof)
 }
 
 func ProvideNodeAPIProofHandler(b NodeAPIBackend) *proofapi.Handler {
 	return proofapi.NewHandler(b)
 }
+
+func ProvideNodeAPIValidatorHandler(feeRecipients *feerecipient.Registry) *validatorapi.Handler {
+	return validatorapi.NewHandler(feeRecipients)
+}
@@ -26,14 +26,18 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 type AttributesFactoryInput struct {
 	depinject.In
 
-	ChainSpec chain.Spec
-	Config    *config.Config
-	Logger    *phuslu.Logger
+	ChainSpec     chain.Spec
+	Config        *config.Config
+	FeeRecipients *feerecipient.Registry
+	Logger        *phuslu.Logger
+	Signer        crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -42,5 +46,7 @@ func ProvideAttributesFactory(in AttributesFactoryInput) (*attributes.Factory, e
 		in.ChainSpec,
 		in.Logger,
 		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.FeeRecipients,
+		in.Signer.PublicKey(),
 	), nil
 }
@@ -28,7 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/da/kzg"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/cast"
 )
 
@@ -38,7 +38,7 @@ type BlobProofVerifierInput struct {
 	depinject.In
 
 	AppOpts          config.AppOptions
-	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
+	JSONTrustedSetup *goethkzg.JSONTrustedSetup
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
@@ -47,6 +48,7 @@ type ChainServiceInput struct {
 	StorageBackend        *storage.Backend
 	BlobProcessor         BlobProcessor
 	TelemetrySink         *metrics.TelemetrySink
+	ValidatorMonitor      *validatormonitor.Monitor
 	BeaconDepositContract deposit.Contract
 }
 
@@ -62,6 +64,7 @@ func ProvideChainService(in ChainServiceInput) *blockchain.Service {
 		in.LocalBuilder,
 		in.StateProcessor,
 		in.TelemetrySink,
+		in.ValidatorMonitor,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
 	)
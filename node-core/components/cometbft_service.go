@@ -23,11 +23,13 @@ package components
 import (
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/profiling"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -41,6 +43,8 @@ func ProvideCometBFTService(
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	telemetrySink *metrics.TelemetrySink,
+	profiler *profiling.Profiler,
+	chainSpec chain.Spec,
 ) *cometbft.Service {
 	return cometbft.NewService(
 		logger,
@@ -49,6 +53,10 @@ func ProvideCometBFTService(
 		blockBuilder,
 		cmtCfg,
 		telemetrySink,
-		builder.DefaultServiceOptions(appOpts)...,
+		append(
+			builder.DefaultServiceOptions(appOpts),
+			cometbft.SetProfiler(profiler),
+			cometbft.SetForkSpec(chainSpec),
+		)...,
 	)
 }
@@ -23,6 +23,7 @@ package components
 import (
 	"context"
 
+	lightclient "github.com/berachain/beacon-kit/beacon/light-client"
 	"github.com/berachain/beacon-kit/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
@@ -518,6 +519,9 @@ type (
 		GenesisBackend
 		BlobBackend
 		BlockBackend
+		DepositBackend
+		FinalityBackend
+		LightClientBackend
 		RandaoBackend
 		StateBackend
 		ValidatorBackend
@@ -546,6 +550,19 @@ type (
 		GenesisTime() (math.U64, error)
 	}
 
+	DepositBackend interface {
+		DepositSnapshot() *types.DepositSnapshotData
+		PendingDepositsAtState(st *statedb.StateDB, limit uint64) ([]*types.PendingDepositData, error)
+	}
+
+	FinalityBackend interface {
+		FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error)
+	}
+
+	LightClientBackend interface {
+		LightClientBlockAtSlot(slot math.Slot) (*lightclient.LightBlock, common.Version, error)
+	}
+
 	RandaoBackend interface {
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
@@ -581,5 +598,9 @@ type (
 			slot math.Slot,
 			ids []string,
 		) ([]*types.ValidatorBalanceData, error)
+		ValidatorIdentitiesByIDs(
+			slot math.Slot,
+			ids []string,
+		) ([]*types.ValidatorIdentityData, error)
 	}
 )
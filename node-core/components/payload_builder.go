@@ -38,6 +38,13 @@ type LocalBuilderInput struct {
 	ChainSpec         chain.Spec
 	ExecutionEngine   *engine.Engine
 	Logger            *phuslu.Logger
+	PayloadIDCache    *cache.PayloadIDCache
+}
+
+// ProvidePayloadIDCache provides the cache of the payload IDs requested by
+// the local builder.
+func ProvidePayloadIDCache() *cache.PayloadIDCache {
+	return cache.NewPayloadIDCache()
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -48,7 +55,7 @@ func ProvideLocalBuilder(in LocalBuilderInput) *payloadbuilder.PayloadBuilder {
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
-		cache.NewPayloadIDCache(),
+		in.PayloadIDCache,
 		in.AttributesFactory,
 	)
 }
@@ -28,11 +28,15 @@ import (
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/elsync"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/services/shutdown"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
 	"github.com/berachain/beacon-kit/node-core/services/version"
 	"github.com/berachain/beacon-kit/node-core/types"
-	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/logexport"
+	obsmetrics "github.com/berachain/beacon-kit/observability/metrics"
+	"github.com/berachain/beacon-kit/observability/tracing"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -40,12 +44,16 @@ type ServiceRegistryInput struct {
 	depinject.In
 	ChainService     *blockchain.Service
 	EngineClient     *client.EngineClient
+	ELSyncMonitor    *elsync.Monitor
 	Logger           *phuslu.Logger
 	NodeAPIServer    *server.Server
 	ReportingService *version.ReportingService
 	TelemetrySink    *metrics.TelemetrySink
-	TelemetryService *telemetry.Service
+	MetricsService   *obsmetrics.Service
+	TracingService   *tracing.Service
+	LogExporter      *logexport.Exporter
 	ValidatorService *validator.Service
+	ValidatorMonitor *validatormonitor.Monitor
 	CometBFTService  types.ConsensusService
 	ShutdownService  *shutdown.Service
 }
@@ -62,10 +70,14 @@ func ProvideServiceRegistry(in ServiceRegistryInput) *service.Registry {
 		service.WithService(in.ValidatorService),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
-		service.WithService(in.TelemetryService),
+		service.WithService(in.MetricsService),
+		service.WithService(in.LogExporter),
+		service.WithService(in.TracingService),
 
 		// engineClient will block until it connects to the execution layer
 		service.WithService(in.EngineClient),
+		service.WithService(in.ELSyncMonitor),
+		service.WithService(in.ValidatorMonitor),
 
 		// only once we connect to an execution client will we start the
 		// chain service and cometbft service
@@ -22,18 +22,24 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain"
 	dablob "github.com/berachain/beacon-kit/da/blob"
+	"github.com/berachain/beacon-kit/da/kzg"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 )
 
 type SidecarFactoryInput struct {
 	depinject.In
 
-	TelemetrySink *metrics.TelemetrySink
+	BlobProofVerifier kzg.BlobProofVerifier
+	ChainSpec         chain.Spec
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 func ProvideSidecarFactory(in SidecarFactoryInput) *dablob.SidecarFactory {
 	return dablob.NewSidecarFactory(
+		in.ChainSpec,
+		in.BlobProofVerifier,
 		in.TelemetrySink,
 	)
 }
@@ -62,7 +62,8 @@ func New[NodeT types.Node](shutdownTimeout time.Duration, registry *service.Regi
 	return types.Node(n).(NodeT)
 }
 
-// Start starts the node.
+// Start starts the node and blocks until it is shut down, upon SIGINT,
+// SIGTERM or the cancellation of the given context.
 func (n *node) Start(
 	ctx context.Context,
 ) error {
@@ -90,7 +91,13 @@ func (n *node) Start(
 
 	// listen to signals in a separate goroutine
 	go func() {
-		sig := <-sigc
+		var reason error
+		select {
+		case sig := <-sigc:
+			reason = fmt.Errorf("shutdown initiated by signal: %s", sig.String())
+		case <-ctx.Done():
+			reason = fmt.Errorf("shutdown initiated by context: %w", context.Cause(ctx))
+		}
 
 		timeout := time.AfterFunc(n.shutdownTimeout, func() {
 			n.logger.Error("Shutdown timeout exceeded, forcing exit", "timeout", n.shutdownTimeout.String())
@@ -99,7 +106,7 @@ func (n *node) Start(
 		defer timeout.Stop()
 
 		once.Do(func() {
-			shutdownFunc(fmt.Errorf("shutdown initiated by signal: %s", sig.String()))
+			shutdownFunc(reason)
 		})
 	}()
 
@@ -111,7 +118,7 @@ func (n *node) Start(
 		return err
 	}
 
-	// we wait here until the signal handler has shutdown the node
+	// we wait here until the node has been shut down
 	<-stop
 
 	return nil
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package elsync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// pollInterval is the interval at which the execution client is polled.
+	pollInterval = 5 * time.Second
+	// pollTimeout bounds each request to the execution client.
+	pollTimeout = 2 * time.Second
+	// stallTimeout is how long the execution client head may stay put while
+	// it is behind before the monitor warns about it.
+	stallTimeout = time.Minute
+)
+
+// Monitor periodically compares the execution client head with the latest
+// payload committed to the beacon state, reports it as metrics and warns
+// when the execution client is offline or stalls.
+type Monitor struct {
+	logger  log.Logger
+	client  ExecutionClient
+	backend StateBackend
+	sink    TelemetrySink
+
+	// mu protects the fields below.
+	mu     sync.RWMutex
+	status Status
+	// lastAdvance is when the execution client head last moved.
+	lastAdvance time.Time
+	// lastStallWarning is when the last stall warning was logged.
+	lastStallWarning time.Time
+}
+
+// NewMonitor creates a new execution client sync monitor.
+func NewMonitor(
+	logger log.Logger,
+	client ExecutionClient,
+	backend StateBackend,
+	telemetrySink TelemetrySink,
+) *Monitor {
+	return &Monitor{
+		logger:  logger,
+		client:  client,
+		backend: backend,
+		sink:    telemetrySink,
+	}
+}
+
+// Name returns the name of the service.
+func (*Monitor) Name() string {
+	return "el-sync-monitor"
+}
+
+// Start starts polling the execution client.
+func (m *Monitor) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			m.Update(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the monitor.
+func (*Monitor) Stop() error {
+	return nil
+}
+
+// Status returns the last observed sync status.
+func (m *Monitor) Status() Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.status
+}
+
+// Update refreshes the sync status, reports it and returns it.
+func (m *Monitor) Update(ctx context.Context) Status {
+	status := Status{UpdatedAt: time.Now()}
+	m.fillBeaconHead(&status)
+	m.fillExecutionHead(ctx, &status)
+
+	m.mu.Lock()
+	prev := m.status
+	m.status = status
+	if status.ELHead != prev.ELHead || m.lastAdvance.IsZero() {
+		m.lastAdvance = status.UpdatedAt
+	}
+	stalledFor := status.UpdatedAt.Sub(m.lastAdvance)
+	warnStall := !status.ELOffline && status.IsOptimistic() &&
+		stalledFor >= stallTimeout &&
+		status.UpdatedAt.Sub(m.lastStallWarning) >= stallTimeout
+	if warnStall {
+		m.lastStallWarning = status.UpdatedAt
+	}
+	m.mu.Unlock()
+
+	m.report(status)
+	m.logTransitions(prev, status)
+	if warnStall {
+		m.logger.Warn(
+			"Execution client head is not advancing, check its logs and peer count",
+			"stalled_for", stalledFor.Round(time.Second),
+			"el_head", status.ELHead,
+			"cl_payload_number", status.PayloadNumber,
+			"el_syncing", status.ELSyncing,
+		)
+	}
+	return status
+}
+
+// fillBeaconHead sets the latest payload committed to the beacon state.
+func (m *Monitor) fillBeaconHead(status *Status) {
+	st, slot, err := m.backend.StateAtSlot(0)
+	if err != nil {
+		m.logger.Debug("Failed to read beacon head state", log.KeyError, err)
+		return
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		m.logger.Debug("Failed to read latest payload header", log.KeyError, err)
+		return
+	}
+	status.HeadSlot = slot
+	status.PayloadNumber = header.GetNumber()
+}
+
+// fillExecutionHead sets the head and sync progress of the execution client.
+func (m *Monitor) fillExecutionHead(ctx context.Context, status *Status) {
+	if !m.client.IsConnected() {
+		status.ELOffline = true
+		return
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, pollTimeout)
+	defer cancel()
+	progress, err := m.client.Syncing(cctx)
+	if err != nil {
+		m.logger.Debug("eth_syncing failed", log.KeyError, err)
+		status.ELOffline = true
+		return
+	}
+	head, err := m.client.BlockNumber(cctx)
+	if err != nil {
+		m.logger.Debug("eth_blockNumber failed", log.KeyError, err)
+		status.ELOffline = true
+		return
+	}
+	status.ELHead = head
+	if progress != nil {
+		status.ELSyncing = true
+		status.ELHighest = progress.HighestBlock
+	}
+}
+
+// report exports the status as metrics.
+func (m *Monitor) report(status Status) {
+	m.setGauge("beacon_kit.el_sync.el_head", status.ELHead.Unwrap())
+	m.setGauge("beacon_kit.el_sync.el_highest", status.ELHighest.Unwrap())
+	m.setGauge("beacon_kit.el_sync.cl_payload_number", status.PayloadNumber.Unwrap())
+	m.setGauge("beacon_kit.el_sync.distance", status.Distance().Unwrap())
+	m.setGauge("beacon_kit.el_sync.el_syncing", boolToGauge(status.ELSyncing))
+	m.setGauge("beacon_kit.el_sync.el_offline", boolToGauge(status.ELOffline))
+	m.setGauge("beacon_kit.el_sync.is_optimistic", boolToGauge(status.IsOptimistic()))
+	if status.ELOffline {
+		m.sink.IncrementCounter("beacon_kit.el_sync.el_offline_polls")
+	}
+}
+
+// setGauge sets the gauge with the given key.
+func (m *Monitor) setGauge(key string, value uint64) {
+	m.sink.SetGauge(key, int64(value)) //#nosec:G115 // block numbers fit in int64.
+}
+
+// logTransitions logs when the execution client goes offline, starts
+// syncing or recovers.
+func (m *Monitor) logTransitions(prev, status Status) {
+	switch {
+	case status.ELOffline && !prev.ELOffline:
+		m.logger.Warn(
+			"Execution client is offline, blocks cannot be verified or built. " +
+				"Check that it is running and reachable at rpc-dial-url with the same JWT secret",
+		)
+	case status.ELSyncing && !prev.ELSyncing:
+		m.logger.Warn(
+			"Execution client is syncing, the beacon head is optimistic until it catches up",
+			"el_head", status.ELHead,
+			"el_highest", status.ELHighest,
+			"cl_payload_number", status.PayloadNumber,
+		)
+	case !status.IsOptimistic() && prev.IsOptimistic() && !prev.UpdatedAt.IsZero():
+		m.logger.Info(
+			"Execution client is in sync with the beacon head",
+			"el_head", status.ELHead,
+		)
+	}
+}
+
+// boolToGauge converts a flag to a gauge value.
+func boolToGauge(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package elsync_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/elsync"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/stretchr/testify/require"
+)
+
+type stubClient struct {
+	connected bool
+	progress  *ethclient.SyncProgress
+	head      math.U64
+	err       error
+}
+
+func (c *stubClient) IsConnected() bool { return c.connected }
+
+func (c *stubClient) Syncing(context.Context) (*ethclient.SyncProgress, error) {
+	return c.progress, c.err
+}
+
+func (c *stubClient) BlockNumber(context.Context) (math.U64, error) {
+	return c.head, c.err
+}
+
+// noStateBackend fails to provide a state, as before genesis.
+type noStateBackend struct{}
+
+func (noStateBackend) StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error) {
+	return nil, slot, errors.New("no state")
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		status     elsync.Status
+		distance   math.U64
+		optimistic bool
+	}{
+		{"in sync", elsync.Status{ELHead: 10, PayloadNumber: 10}, 0, false},
+		{"ahead", elsync.Status{ELHead: 11, PayloadNumber: 10}, 0, false},
+		{"behind", elsync.Status{ELHead: 7, PayloadNumber: 10}, 3, true},
+		{"syncing", elsync.Status{ELHead: 10, PayloadNumber: 10, ELSyncing: true}, 0, true},
+		{"offline", elsync.Status{ELOffline: true}, 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.distance, tc.status.Distance())
+			require.Equal(t, tc.optimistic, tc.status.IsOptimistic())
+		})
+	}
+}
+
+func TestMonitorUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := &stubClient{
+		connected: true,
+		progress:  &ethclient.SyncProgress{CurrentBlock: 5, HighestBlock: 10},
+		head:      5,
+	}
+	m := elsync.NewMonitor(
+		noop.NewLogger[any](), client, noStateBackend{}, metrics.NewNoOpTelemetrySink(),
+	)
+	require.True(t, m.Status().UpdatedAt.IsZero())
+
+	status := m.Update(ctx)
+	require.False(t, status.ELOffline)
+	require.True(t, status.ELSyncing)
+	require.Equal(t, math.U64(5), status.ELHead)
+	require.Equal(t, math.U64(10), status.ELHighest)
+	require.True(t, status.IsOptimistic())
+	require.Equal(t, status, m.Status())
+
+	client.progress = nil
+	status = m.Update(ctx)
+	require.False(t, status.ELSyncing)
+	require.False(t, status.IsOptimistic())
+
+	client.err = errors.New("connection refused")
+	status = m.Update(ctx)
+	require.True(t, status.ELOffline)
+	require.True(t, status.IsOptimistic())
+
+	client.err, client.connected = nil, false
+	require.True(t, m.Update(ctx).ELOffline)
+}
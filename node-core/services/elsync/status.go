@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package elsync
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Status is the sync status of the execution client relative to the
+// beacon chain.
+type Status struct {
+	// HeadSlot is the slot of the latest committed beacon state.
+	HeadSlot math.Slot
+	// PayloadNumber is the number of the latest execution payload header
+	// in the beacon state.
+	PayloadNumber math.U64
+	// ELHead is the number of the execution client head block.
+	ELHead math.U64
+	// ELHighest is the highest block known to the execution client while
+	// it is syncing.
+	ELHighest math.U64
+	// ELSyncing is true if the execution client reports it is syncing.
+	ELSyncing bool
+	// ELOffline is true if the execution client could not be reached.
+	ELOffline bool
+	// UpdatedAt is when the status was last refreshed.
+	UpdatedAt time.Time
+}
+
+// Distance returns how many blocks the execution client head is behind the
+// latest payload committed to the beacon state.
+func (s Status) Distance() math.U64 {
+	if s.ELHead >= s.PayloadNumber {
+		return 0
+	}
+	return s.PayloadNumber - s.ELHead
+}
+
+// IsOptimistic returns true if the beacon chain head has not been verified
+// by the execution client, i.e. the execution client is offline, syncing
+// or behind the latest committed payload.
+func (s Status) IsOptimistic() bool {
+	return s.ELOffline || s.ELSyncing || s.Distance() > 0
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/berachain/beacon-kit/log"
 )
@@ -51,6 +52,11 @@ type Registry struct {
 	servicesStarted map[string]struct{}
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// mu protects statuses, which are read concurrently with StartAll and
+	// StopAll.
+	mu sync.RWMutex
+	// statuses is a map of service type -> service status.
+	statuses map[string]ServiceStatus
 }
 
 // NewRegistry starts a registry instance for convenience.
@@ -59,6 +65,7 @@ func NewRegistry(logger log.Logger, opts ...RegistryOption) *Registry {
 		logger:          logger,
 		services:        make(map[string]Basic),
 		servicesStarted: make(map[string]struct{}),
+		statuses:        make(map[string]ServiceStatus),
 	}
 
 	for _, opt := range opts {
@@ -82,10 +89,12 @@ func (s *Registry) StartAll(ctx context.Context) error {
 		}
 
 		if err := svc.Start(ctx); err != nil {
+			s.setStatus(typeName, StatusFailed, err)
 			return fmt.Errorf("error when starting service %s: %w", typeName, err)
 		}
 
 		s.servicesStarted[typeName] = struct{}{}
+		s.setStatus(typeName, StatusRunning, nil)
 	}
 	s.logger.Info("All services started", "num", len(s.servicesStarted))
 	return nil
@@ -110,8 +119,11 @@ func (s *Registry) StopAll() {
 		}
 
 		if err := svc.Stop(); err != nil {
-			s.logger.Error("error when stopping service", "type", typeName, "err", err)
+			s.logger.Error("error when stopping service", "type", typeName, log.KeyError, err)
+			s.setStatus(typeName, StatusFailed, err)
+			continue
 		}
+		s.setStatus(typeName, StatusStopped, nil)
 	}
 	s.logger.Info("All services stopped", "num", len(s.servicesStarted))
 }
@@ -125,6 +137,7 @@ func (s *Registry) RegisterService(service Basic) error {
 	}
 	s.services[typeName] = service
 	s.serviceTypes = append(s.serviceTypes, typeName)
+	s.setStatus(typeName, StatusRegistered, nil)
 	return nil
 }
 
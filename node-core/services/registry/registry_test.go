@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -83,3 +84,38 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+func TestRegistry_Statuses(t *testing.T) {
+	t.Parallel()
+	logger := noop.NewLogger[any]()
+	registry := service.NewRegistry(logger)
+	errStart := errors.New("start failed")
+
+	service1 := &mocks.Basic{}
+	service1.On("Start", mock.Anything).Return(nil).Once()
+	service1.On("Stop").Return(nil).Once()
+	service1.On("Name").Return("Service1")
+
+	service2 := &mocks.Basic{}
+	service2.On("Start", mock.Anything).Return(errStart).Once()
+	service2.On("Name").Return("Service2")
+
+	require.NoError(t, registry.RegisterService(service1))
+	require.NoError(t, registry.RegisterService(service2))
+	require.Equal(t, []service.ServiceStatus{
+		{Name: "Service1", Status: service.StatusRegistered},
+		{Name: "Service2", Status: service.StatusRegistered},
+	}, registry.Statuses())
+
+	require.ErrorIs(t, registry.StartAll(context.Background()), errStart)
+	require.Equal(t, []service.ServiceStatus{
+		{Name: "Service1", Status: service.StatusRunning},
+		{Name: "Service2", Status: service.StatusFailed, Error: errStart},
+	}, registry.Statuses())
+
+	registry.StopAll()
+	require.Equal(t, []service.ServiceStatus{
+		{Name: "Service1", Status: service.StatusStopped},
+		{Name: "Service2", Status: service.StatusFailed, Error: errStart},
+	}, registry.Statuses())
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service
+
+// Status is the lifecycle status of a registered service.
+type Status string
+
+const (
+	// StatusRegistered is the status of a service that has not been started.
+	StatusRegistered Status = "registered"
+	// StatusRunning is the status of a service that has been started.
+	StatusRunning Status = "running"
+	// StatusFailed is the status of a service that failed to start or stop.
+	StatusFailed Status = "failed"
+	// StatusStopped is the status of a service that has been stopped.
+	StatusStopped Status = "stopped"
+)
+
+// ServiceStatus reports the status of a registered service.
+type ServiceStatus struct {
+	// Name is the name of the service.
+	Name string
+	// Status is the lifecycle status of the service.
+	Status Status
+	// Error is the error the service failed with, if any.
+	Error error
+}
+
+// Statuses returns the status of every registered service, in order of
+// registration.
+func (s *Registry) Statuses() []ServiceStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	statuses := make([]ServiceStatus, len(s.serviceTypes))
+	for i, typeName := range s.serviceTypes {
+		statuses[i] = s.statuses[typeName]
+	}
+	return statuses
+}
+
+// setStatus records the status of the given service.
+func (s *Registry) setStatus(typeName string, status Status, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[typeName] = ServiceStatus{
+		Name:   typeName,
+		Status: status,
+		Error:  err,
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatormonitor
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ChainSpec is the view of the chain spec used by the monitor.
+type ChainSpec interface {
+	// SlotToEpoch returns the epoch of the given slot.
+	SlotToEpoch(slot math.Slot) math.Epoch
+}
+
+// State is the view of the beacon state read by the monitor.
+type State interface {
+	// ValidatorIndexByPubkey returns the index of the validator with the
+	// given public key.
+	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
+	// ValidatorIndexByCometBFTAddress returns the index of the validator
+	// with the given CometBFT address.
+	ValidatorIndexByCometBFTAddress(address []byte) (math.ValidatorIndex, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(index math.ValidatorIndex) (*ctypes.Validator, error)
+	// GetBalance returns the balance of the validator at the given index.
+	GetBalance(index math.ValidatorIndex) (math.Gwei, error)
+}
+
+// ProposerSchedule resolves the proposers scheduled by CometBFT.
+type ProposerSchedule interface {
+	// ExpectedProposer returns the CometBFT address of the validator
+	// scheduled to propose the block at the given height in its first round.
+	ExpectedProposer(height int64) ([]byte, error)
+}
+
+// TelemetrySink is an interface for sending telemetry data.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
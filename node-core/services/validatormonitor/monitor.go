@@ -0,0 +1,365 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatormonitor
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// Monitor tracks the performance of the validators of the node operator.
+// On every finalized block it records the proposals made and missed, the
+// withdrawals received and the changes of balance, effective balance and
+// exit status of each monitored validator, reports them as per-validator
+// metrics and logs a summary at the end of each epoch.
+type Monitor struct {
+	logger    log.Logger
+	chainSpec ChainSpec
+	sink      TelemetrySink
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// proposers resolves the proposers expected by CometBFT, it is nil until
+	// the consensus service is attached.
+	proposers  ProposerSchedule
+	validators []*Status
+	// epoch is the epoch of the last observed block.
+	epoch math.Epoch
+	// observed is false until the first block is observed.
+	observed bool
+}
+
+// NewMonitor creates a validator monitor for the public keys of the config.
+func NewMonitor(
+	cfg Config,
+	logger log.Logger,
+	chainSpec ChainSpec,
+	telemetrySink TelemetrySink,
+) (*Monitor, error) {
+	validators := make([]*Status, 0, len(cfg.Pubkeys))
+	seen := make(map[crypto.BLSPubkey]struct{}, len(cfg.Pubkeys))
+	for _, key := range cfg.Pubkeys {
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(key)); err != nil {
+			return nil, errors.Wrapf(err, "invalid validator pubkey %q", key)
+		}
+		if _, ok := seen[pubkey]; ok {
+			continue
+		}
+		seen[pubkey] = struct{}{}
+		validators = append(validators, &Status{Pubkey: pubkey})
+	}
+	return &Monitor{
+		logger:     logger,
+		chainSpec:  chainSpec,
+		sink:       telemetrySink,
+		validators: validators,
+	}, nil
+}
+
+// Name returns the name of the service.
+func (*Monitor) Name() string {
+	return "validator-monitor"
+}
+
+// Start starts the monitor. Blocks are observed as they are finalized.
+func (m *Monitor) Start(context.Context) error {
+	if len(m.validators) > 0 {
+		m.logger.Info("Monitoring validators", "count", len(m.validators))
+	}
+	return nil
+}
+
+// Stop stops the monitor.
+func (*Monitor) Stop() error {
+	return nil
+}
+
+// AttachProposerSchedule sets the schedule of the CometBFT proposers, used to
+// detect missed proposals. It is attached once the consensus service is built.
+func (m *Monitor) AttachProposerSchedule(proposers ProposerSchedule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.proposers = proposers
+}
+
+// Statuses returns the status of the monitored validators.
+func (m *Monitor) Statuses() []Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	statuses := make([]Status, len(m.validators))
+	for i, v := range m.validators {
+		statuses[i] = *v
+	}
+	return statuses
+}
+
+// OnFinalizeBlock observes a finalized block, given its post-state.
+func (m *Monitor) OnFinalizeBlock(
+	st *statedb.StateDB,
+	blk *types.ConsensusBlock,
+) {
+	m.Observe(st, blk.GetBeaconBlock(), blk.GetProposerAddress())
+}
+
+// Observe records the performance of the monitored validators in blk, where
+// st is the post-state of blk and proposerAddress the CometBFT address of
+// its proposer.
+func (m *Monitor) Observe(
+	st State,
+	blk *ctypes.BeaconBlock,
+	proposerAddress []byte,
+) {
+	if len(m.validators) == 0 {
+		return
+	}
+
+	m.mu.RLock()
+	proposers := m.proposers
+	m.mu.RUnlock()
+	missedBy, missed := m.missedProposer(st, proposers, blk.GetSlot(), proposerAddress)
+
+	epoch := m.chainSpec.SlotToEpoch(blk.GetSlot())
+	withdrawals := blk.GetBody().GetExecutionPayload().GetWithdrawals()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.observed && epoch != m.epoch {
+		m.logSummary()
+		for _, v := range m.validators {
+			v.Epoch = Performance{}
+		}
+	}
+	m.epoch, m.observed = epoch, true
+
+	for _, v := range m.validators {
+		first := !v.Known
+		if err := m.resolve(st, v); err != nil || !v.Known {
+			m.logFailure(v, err)
+			continue
+		}
+		var perf Performance
+		switch {
+		case blk.GetProposerIndex() == v.Index:
+			perf.ProposalsMade++
+		case missed && missedBy == v.Index:
+			perf.ProposalsMissed++
+		}
+		m.logFailure(v, m.observe(st, v, first, withdrawals, perf))
+	}
+}
+
+// missedProposer returns the index of the validator which missed proposing
+// the block at slot, if any. CometBFT only accepts blocks proposed by the
+// proposer of their round, so a block proposed by another validator than the
+// one scheduled in the first round means that the latter missed its proposal.
+func (m *Monitor) missedProposer(
+	st State,
+	proposers ProposerSchedule,
+	slot math.Slot,
+	proposerAddress []byte,
+) (math.ValidatorIndex, bool) {
+	if proposers == nil {
+		return 0, false
+	}
+	expected, err := proposers.ExpectedProposer(int64(slot.Unwrap())) // #nosec G115 // slots fit in heights.
+	if err != nil {
+		m.logger.Debug(
+			"Failed to resolve the scheduled proposer of the block",
+			"slot", slot, "error", err,
+		)
+		return 0, false
+	}
+	if bytes.Equal(expected, proposerAddress) {
+		return 0, false
+	}
+	index, err := st.ValidatorIndexByCometBFTAddress(expected)
+	if err != nil {
+		m.logger.Warn(
+			"Failed to resolve the scheduled proposer of the block",
+			"slot", slot, "error", err,
+		)
+		return 0, false
+	}
+	return index, true
+}
+
+// resolve looks up the index of v in the registry of st if it is not known
+// yet. v stays unknown if it has not deposited.
+func (m *Monitor) resolve(st State, v *Status) error {
+	if v.Known {
+		return nil
+	}
+	index, err := st.ValidatorIndexByPubkey(v.Pubkey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	v.Known, v.Index = true, index
+	m.logger.Info(
+		"Monitored validator found in the registry",
+		log.KeyValidatorIndex, index, log.KeyPubkey, v.Pubkey.String(),
+	)
+	return nil
+}
+
+// logFailure logs err, if any, as a failure to monitor v.
+func (m *Monitor) logFailure(v *Status, err error) {
+	if err != nil {
+		m.logger.Error(
+			"Failed to monitor validator",
+			"pubkey", v.Pubkey.String(), "error", err,
+		)
+	}
+}
+
+// observe refreshes the status of v from st and records perf, along with
+// the withdrawals received by v. Balance changes are not recorded when v is
+// observed for the first time.
+func (m *Monitor) observe(
+	st State,
+	v *Status,
+	first bool,
+	withdrawals engineprimitives.Withdrawals,
+	perf Performance,
+) error {
+	val, err := st.ValidatorByIndex(v.Index)
+	if err != nil {
+		return err
+	}
+	balance, err := st.GetBalance(v.Index)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range withdrawals {
+		if w.GetValidatorIndex() == v.Index {
+			perf.Withdrawals++
+			perf.Withdrawn += w.GetAmount()
+		}
+	}
+	effectiveBalance := val.GetEffectiveBalance()
+	if !first {
+		//#nosec:G115 // balances fit in int64.
+		perf.BalanceChange = int64(balance) - int64(v.Balance)
+		if effectiveBalance != v.EffectiveBalance {
+			perf.EffectiveBalanceChanges++
+			m.logger.Info(
+				"Monitored validator effective balance changed",
+				log.KeyValidatorIndex, v.Index,
+				"from", v.EffectiveBalance, "to", effectiveBalance,
+			)
+		}
+	}
+	v.Balance, v.EffectiveBalance = balance, effectiveBalance
+
+	if !v.Exiting && val.GetExitEpoch() != constants.FarFutureEpoch {
+		v.Exiting, v.ExitEpoch = true, val.GetExitEpoch()
+		m.logger.Warn(
+			"Monitored validator is exiting",
+			log.KeyValidatorIndex, v.Index,
+			"exit_epoch", val.GetExitEpoch(),
+			"withdrawable_epoch", val.GetWithdrawableEpoch(),
+		)
+	}
+
+	v.Epoch.add(perf)
+	v.Total.add(perf)
+	m.report(v, perf)
+	return nil
+}
+
+// report emits the metrics of v, incrementing the counters by perf.
+func (m *Monitor) report(v *Status, perf Performance) {
+	labels := []string{"validator", v.Index.Base10()}
+	m.increment("beacon_kit.validator_monitor.proposals_made", perf.ProposalsMade, labels)
+	m.increment("beacon_kit.validator_monitor.proposals_missed", perf.ProposalsMissed, labels)
+	m.increment("beacon_kit.validator_monitor.withdrawals", perf.Withdrawals, labels)
+	m.increment(
+		"beacon_kit.validator_monitor.effective_balance_changes",
+		perf.EffectiveBalanceChanges, labels,
+	)
+
+	m.setGauge("beacon_kit.validator_monitor.balance", v.Balance.Unwrap(), labels)
+	m.setGauge(
+		"beacon_kit.validator_monitor.effective_balance",
+		v.EffectiveBalance.Unwrap(), labels,
+	)
+	m.setGauge("beacon_kit.validator_monitor.withdrawn", v.Total.Withdrawn.Unwrap(), labels)
+	var exiting uint64
+	if v.Exiting {
+		exiting = 1
+	}
+	m.setGauge("beacon_kit.validator_monitor.exiting", exiting, labels)
+}
+
+// increment increments the counter with the given key n times.
+func (m *Monitor) increment(key string, n uint64, labels []string) {
+	for range n {
+		m.sink.IncrementCounter(key, labels...)
+	}
+}
+
+// setGauge sets the gauge with the given key.
+func (m *Monitor) setGauge(key string, value uint64, labels []string) {
+	m.sink.SetGauge(key, int64(value), labels...) //#nosec:G115 // Gwei fit in int64.
+}
+
+// logSummary logs the performance of the monitored validators in the last
+// observed epoch.
+func (m *Monitor) logSummary() {
+	for _, v := range m.validators {
+		if !v.Known {
+			m.logger.Info(
+				"Monitored validator is not in the registry",
+				"epoch", m.epoch, "pubkey", v.Pubkey.String(),
+			)
+			continue
+		}
+		m.logger.Info(
+			"Monitored validator epoch summary",
+			"epoch", m.epoch,
+			log.KeyValidatorIndex, v.Index,
+			"balance", v.Balance,
+			"balance_change", v.Epoch.BalanceChange,
+			"effective_balance", v.EffectiveBalance,
+			"proposals_made", v.Epoch.ProposalsMade,
+			"proposals_missed", v.Epoch.ProposalsMissed,
+			"withdrawals", v.Epoch.Withdrawals,
+			"withdrawn", v.Epoch.Withdrawn,
+			"exiting", v.Exiting,
+		)
+	}
+}
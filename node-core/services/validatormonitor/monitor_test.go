@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatormonitor_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerEpoch = 8
+
+type stubChainSpec struct{}
+
+func (stubChainSpec) SlotToEpoch(slot math.Slot) math.Epoch {
+	return math.Epoch(slot / slotsPerEpoch)
+}
+
+// fakeState holds a registry where the CometBFT address of a validator is
+// its index as a single byte.
+type fakeState struct {
+	pubkeys    map[crypto.BLSPubkey]math.ValidatorIndex
+	validators map[math.ValidatorIndex]*ctypes.Validator
+	balances   map[math.ValidatorIndex]math.Gwei
+}
+
+func (s *fakeState) ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error) {
+	index, ok := s.pubkeys[pubkey]
+	if !ok {
+		return 0, collections.ErrNotFound
+	}
+	return index, nil
+}
+
+func (s *fakeState) ValidatorIndexByCometBFTAddress(address []byte) (math.ValidatorIndex, error) {
+	index := math.ValidatorIndex(address[0])
+	if _, ok := s.validators[index]; !ok {
+		return 0, collections.ErrNotFound
+	}
+	return index, nil
+}
+
+func (s *fakeState) ValidatorByIndex(index math.ValidatorIndex) (*ctypes.Validator, error) {
+	return s.validators[index], nil
+}
+
+func (s *fakeState) GetBalance(index math.ValidatorIndex) (math.Gwei, error) {
+	return s.balances[index], nil
+}
+
+func (s *fakeState) add(pubkey crypto.BLSPubkey, index math.ValidatorIndex, balance math.Gwei) {
+	s.pubkeys[pubkey] = index
+	s.validators[index] = &ctypes.Validator{
+		Pubkey:           pubkey,
+		EffectiveBalance: balance,
+		ExitEpoch:        constants.FarFutureEpoch,
+	}
+	s.balances[index] = balance
+}
+
+// stubProposers schedules the proposers of the first round by height, as
+// CometBFT addresses. Heights without scheduled proposer are unknown.
+type stubProposers map[int64][]byte
+
+func (p stubProposers) ExpectedProposer(height int64) ([]byte, error) {
+	address, ok := p[height]
+	if !ok {
+		return nil, errors.New("unknown height")
+	}
+	return address, nil
+}
+
+func newBlock(
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	withdrawals ...*engineprimitives.Withdrawal,
+) *ctypes.BeaconBlock {
+	return &ctypes.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposer,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{Withdrawals: withdrawals},
+		},
+	}
+}
+
+func TestNewMonitorInvalidPubkey(t *testing.T) {
+	t.Parallel()
+	cfg := validatormonitor.Config{Pubkeys: []string{"0x1234"}}
+	_, err := validatormonitor.NewMonitor(
+		cfg, noop.NewLogger[any](), stubChainSpec{}, metrics.NewNoOpTelemetrySink(),
+	)
+	require.Error(t, err)
+}
+
+func TestMonitorObserve(t *testing.T) {
+	t.Parallel()
+	monitored := crypto.BLSPubkey{0x01}
+	unknown := crypto.BLSPubkey{0x02}
+	cfg := validatormonitor.Config{Pubkeys: []string{
+		monitored.String(), unknown.String(), monitored.String(),
+	}}
+	m, err := validatormonitor.NewMonitor(
+		cfg, noop.NewLogger[any](), stubChainSpec{}, metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, err)
+	require.Len(t, m.Statuses(), 2)
+	m.AttachProposerSchedule(stubProposers{
+		1: {1},
+		2: {1},
+		3: {0},
+	})
+
+	st := &fakeState{
+		pubkeys:    make(map[crypto.BLSPubkey]math.ValidatorIndex),
+		validators: make(map[math.ValidatorIndex]*ctypes.Validator),
+		balances:   make(map[math.ValidatorIndex]math.Gwei),
+	}
+	st.add(crypto.BLSPubkey{0xff}, 0, 32e9)
+	st.add(monitored, 1, 32e9)
+
+	// The monitored validator proposes the block.
+	m.Observe(st, newBlock(1, 1), []byte{1})
+	// The monitored validator is scheduled to propose in the first round,
+	// but the block is proposed by another validator in a later round.
+	st.balances[1] = 33e9
+	m.Observe(st, newBlock(2, 0), []byte{0})
+	// Another validator proposes a block with a withdrawal for the
+	// monitored validator, whose effective balance changes.
+	st.balances[1] = 31e9
+	st.validators[1].EffectiveBalance = 31e9
+	m.Observe(st, newBlock(3, 0, &engineprimitives.Withdrawal{
+		Validator: 1, Amount: 2e9,
+	}), []byte{0})
+
+	statuses := m.Statuses()
+	require.True(t, statuses[0].Known)
+	require.Equal(t, math.ValidatorIndex(1), statuses[0].Index)
+	require.Equal(t, math.Gwei(31e9), statuses[0].Balance)
+	require.Equal(t, math.Gwei(31e9), statuses[0].EffectiveBalance)
+	require.False(t, statuses[0].Exiting)
+	require.Equal(t, validatormonitor.Performance{
+		ProposalsMade:           1,
+		ProposalsMissed:         1,
+		Withdrawals:             1,
+		Withdrawn:               2e9,
+		BalanceChange:           -1e9,
+		EffectiveBalanceChanges: 1,
+	}, statuses[0].Total)
+	require.False(t, statuses[1].Known)
+
+	// The epoch performance resets at the epoch boundary, the exit is
+	// detected and the unknown validator is found once it has deposited.
+	st.validators[1].ExitEpoch = 3
+	st.add(unknown, 2, 32e9)
+	m.Observe(st, newBlock(slotsPerEpoch, 2), []byte{2})
+
+	statuses = m.Statuses()
+	require.True(t, statuses[0].Exiting)
+	require.Equal(t, math.Epoch(3), statuses[0].ExitEpoch)
+	require.Equal(t, validatormonitor.Performance{}, statuses[0].Epoch)
+	require.Equal(t, uint64(1), statuses[0].Total.ProposalsMade)
+	require.True(t, statuses[1].Known)
+	require.Equal(t, math.ValidatorIndex(2), statuses[1].Index)
+	require.Equal(t, uint64(1), statuses[1].Epoch.ProposalsMade)
+}
+
+func TestMonitorMissedProposal(t *testing.T) {
+	t.Parallel()
+	monitored := crypto.BLSPubkey{0x01}
+	cfg := validatormonitor.Config{Pubkeys: []string{monitored.String()}}
+	st := &fakeState{
+		pubkeys:    make(map[crypto.BLSPubkey]math.ValidatorIndex),
+		validators: make(map[math.ValidatorIndex]*ctypes.Validator),
+		balances:   make(map[math.ValidatorIndex]math.Gwei),
+	}
+	st.add(crypto.BLSPubkey{0xff}, 0, 32e9)
+	st.add(monitored, 1, 32e9)
+
+	tests := []struct {
+		name      string
+		proposers validatormonitor.ProposerSchedule
+		proposer  math.ValidatorIndex
+		made      uint64
+		missed    uint64
+	}{
+		{
+			name:      "scheduled proposer proposes",
+			proposers: stubProposers{1: {1}},
+			proposer:  1,
+			made:      1,
+		},
+		{
+			name:      "scheduled proposer misses the first round",
+			proposers: stubProposers{1: {1}},
+			proposer:  0,
+			missed:    1,
+		},
+		{
+			name:      "another validator misses the first round",
+			proposers: stubProposers{1: {0}},
+			proposer:  1,
+			made:      1,
+		},
+		{
+			name:      "unknown schedule",
+			proposers: stubProposers{},
+			proposer:  0,
+		},
+		{
+			name:     "no schedule attached",
+			proposer: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := validatormonitor.NewMonitor(
+				cfg, noop.NewLogger[any](), stubChainSpec{}, metrics.NewNoOpTelemetrySink(),
+			)
+			require.NoError(t, err)
+			if tt.proposers != nil {
+				m.AttachProposerSchedule(tt.proposers)
+			}
+
+			m.Observe(st, newBlock(1, tt.proposer), []byte{byte(tt.proposer)})
+			perf := m.Statuses()[0].Total
+			require.Equal(t, tt.made, perf.ProposalsMade)
+			require.Equal(t, tt.missed, perf.ProposalsMissed)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatormonitor
+
+import (
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Performance is the performance of a validator over a period of time.
+type Performance struct {
+	// ProposalsMade is the number of blocks proposed by the validator.
+	ProposalsMade uint64
+	// ProposalsMissed is the number of blocks CometBFT attributed to the
+	// validator but which carry the beacon proposer index of another.
+	ProposalsMissed uint64
+	// Withdrawals is the number of withdrawals received by the validator.
+	Withdrawals uint64
+	// Withdrawn is the amount received through withdrawals.
+	Withdrawn math.Gwei
+	// BalanceChange is the change of the balance of the validator, in Gwei.
+	BalanceChange int64
+	// EffectiveBalanceChanges is the number of changes of the effective
+	// balance of the validator.
+	EffectiveBalanceChanges uint64
+}
+
+// add accumulates other into p.
+func (p *Performance) add(other Performance) {
+	p.ProposalsMade += other.ProposalsMade
+	p.ProposalsMissed += other.ProposalsMissed
+	p.Withdrawals += other.Withdrawals
+	p.Withdrawn += other.Withdrawn
+	p.BalanceChange += other.BalanceChange
+	p.EffectiveBalanceChanges += other.EffectiveBalanceChanges
+}
+
+// Status is the status of a monitored validator.
+type Status struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey
+	// Known is false until the validator is found in the registry.
+	Known bool
+	// Index is the index of the validator in the registry.
+	Index math.ValidatorIndex
+	// Balance is the balance of the validator.
+	Balance math.Gwei
+	// EffectiveBalance is the effective balance of the validator.
+	EffectiveBalance math.Gwei
+	// ExitEpoch is the epoch the validator exits at, if it is exiting.
+	ExitEpoch math.Epoch
+	// Exiting is true once the validator has initiated its exit.
+	Exiting bool
+	// Epoch is the performance of the validator in the current epoch.
+	Epoch Performance
+	// Total is the performance of the validator since the node started.
+	Total Performance
+}
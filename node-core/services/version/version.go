@@ -104,7 +104,7 @@ func (rs *ReportingService) Start(ctx context.Context) error {
 		// log telemetry immediately after we are connected
 		ethVersion, err := rs.GetEthVersion(ctx)
 		if err != nil {
-			rs.logger.Warn("Failed to get eth version", "err", err)
+			rs.logger.Warn("Failed to get eth version", log.KeyError, err)
 		}
 		rs.logTelemetry(ethVersion)
 
@@ -119,7 +119,7 @@ func (rs *ReportingService) Start(ctx context.Context) error {
 				// we need to fetch the version every time
 				ethVersion, err = rs.GetEthVersion(ctx)
 				if err != nil {
-					rs.logger.Warn("Failed to get eth version", "err", err)
+					rs.logger.Warn("Failed to get eth version", log.KeyError, err)
 				}
 
 				// print to console and log telemetry
@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,4 +59,12 @@ type ConsensusService interface {
 		prove bool,
 	) (sdk.Context, error)
 	LastBlockHeight() int64
+	LightBlock(
+		height int64,
+		txIndex int,
+	) (*cmttypes.LightBlock, *cmttypes.TxProof, error)
+	RequestHalt(height int64) error
+	ExpectedProposer(height int64) ([]byte, error)
+	IsCatchingUp() bool
+	NetworkHeight() int64
 }
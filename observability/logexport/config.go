@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logexport
+
+import "time"
+
+const (
+	defaultEndpoint      = "localhost:4318"
+	defaultServiceName   = "beacond"
+	defaultFlushInterval = time.Second
+	defaultQueueSize     = 4096
+)
+
+// DefaultConfig returns the default log export configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		Endpoint:      defaultEndpoint,
+		Insecure:      true,
+		ServiceName:   defaultServiceName,
+		FlushInterval: defaultFlushInterval,
+		QueueSize:     defaultQueueSize,
+	}
+}
+
+// Config is the configuration for exporting logs over OTLP.
+type Config struct {
+	// Enabled determines if logs are exported.
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is the host:port of the OTLP/HTTP collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS towards the collector.
+	Insecure bool `mapstructure:"insecure"`
+	// ServiceName is the service name logs are reported under.
+	ServiceName string `mapstructure:"service-name"`
+	// FlushInterval is the interval queued logs are exported at.
+	FlushInterval time.Duration `mapstructure:"flush-interval"`
+	// QueueSize is the maximum number of logs queued for export. Logs are
+	// dropped while the queue is full.
+	QueueSize int `mapstructure:"queue-size"`
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// logsPath is the path of the OTLP/HTTP logs endpoint.
+	logsPath = "/v1/logs"
+	// exportTimeout bounds the time spent exporting a batch of logs.
+	exportTimeout = 10 * time.Second
+	// maxBatchSize is the maximum number of logs exported in one request.
+	maxBatchSize = 512
+)
+
+// Exporter exports the logs of the node to an OTLP collector. It receives
+// the logs as the sink of the node logger and exports them in batches over
+// OTLP/HTTP.
+type Exporter struct {
+	cfg    Config
+	logger *phuslu.Logger
+	// report logs the export failures. It writes to stderr and is never
+	// exported, so that failing exports do not feed the queue they fail on.
+	report *phuslu.Logger
+	url    string
+	client *http.Client
+	// records queues the logs to export.
+	records chan *phuslu.Record
+	// dropped counts the logs dropped since the last export.
+	dropped atomic.Uint64
+	// failing is true while exports fail, to only log the first failure.
+	failing bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewExporter creates a new log exporter for the given logger.
+func NewExporter(cfg Config, logger *phuslu.Logger) *Exporter {
+	scheme := "https"
+	if cfg.Insecure {
+		scheme = "http"
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	return &Exporter{
+		cfg:     cfg,
+		logger:  logger,
+		report:  phuslu.NewLogger(os.Stderr, nil).With(log.KeyService, "log-exporter"),
+		url:     scheme + "://" + cfg.Endpoint + logsPath,
+		client:  &http.Client{Timeout: exportTimeout},
+		records: make(chan *phuslu.Record, cfg.QueueSize),
+	}
+}
+
+// Name returns the service name.
+func (e *Exporter) Name() string {
+	return "log-exporter"
+}
+
+// Start installs the exporter as the sink of the logger and starts
+// exporting logs.
+func (e *Exporter) Start(ctx context.Context) error {
+	if !e.cfg.Enabled {
+		return nil
+	}
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.wg.Add(1)
+	go e.run(ctx)
+	e.logger.SetSink(e)
+	e.logger.Info("Exporting logs", "endpoint", e.cfg.Endpoint)
+	return nil
+}
+
+// Stop removes the exporter from the logger and exports the queued logs.
+func (e *Exporter) Stop() error {
+	if e.cancel == nil {
+		return nil
+	}
+	e.logger.SetSink(nil)
+	e.cancel()
+	e.wg.Wait()
+	return nil
+}
+
+// Write queues a log for export, dropping it if the queue is full.
+func (e *Exporter) Write(rec *phuslu.Record) {
+	select {
+	case e.records <- rec:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// run exports the queued logs every flush interval, or as soon as a batch
+// is full, until the context is canceled.
+func (e *Exporter) run(ctx context.Context) {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*phuslu.Record, 0, maxBatchSize)
+	for {
+		select {
+		case rec := <-e.records:
+			if batch = append(batch, rec); len(batch) == maxBatchSize {
+				batch = e.flush(batch)
+			}
+		case <-ticker.C:
+			batch = e.flush(batch)
+		case <-ctx.Done():
+			e.drain(batch)
+			return
+		}
+	}
+}
+
+// drain exports the given batch along with the logs left in the queue.
+func (e *Exporter) drain(batch []*phuslu.Record) {
+	for {
+		select {
+		case rec := <-e.records:
+			if batch = append(batch, rec); len(batch) == maxBatchSize {
+				batch = e.flush(batch)
+			}
+		default:
+			e.flush(batch)
+			return
+		}
+	}
+}
+
+// flush exports the given batch and returns it emptied.
+func (e *Exporter) flush(batch []*phuslu.Record) []*phuslu.Record {
+	if dropped := e.dropped.Swap(0); dropped > 0 {
+		e.report.Warn("Dropped logs as the export queue is full", "count", dropped)
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+	err := e.export(batch)
+	switch {
+	case err != nil && !e.failing:
+		e.report.Warn("Failed to export logs", "count", len(batch), log.KeyError, err)
+	case err == nil && e.failing:
+		e.report.Info("Resumed exporting logs")
+	}
+	e.failing = err != nil
+	clear(batch)
+	return batch[:0]
+}
+
+// export sends the given logs to the collector.
+func (e *Exporter) export(records []*phuslu.Record) error {
+	body, err := proto.Marshal(newRequest(e.cfg.ServiceName, records))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	//nolint:errcheck // the body is drained to reuse the connection.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with status %s", resp.Status)
+	}
+	return nil
+}
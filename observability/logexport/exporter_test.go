@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logexport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/observability/logexport"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector records the logs exported to it, failing the first failures
+// requests.
+type collector struct {
+	mu       sync.Mutex
+	records  []*logspb.LogRecord
+	service  string
+	failures int
+	requests int
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.URL.Path != "/v1/logs" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &collogspb.ExportLogsServiceRequest{}
+	if err = proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.requests++; c.requests <= c.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	for _, rl := range req.GetResourceLogs() {
+		c.service = rl.GetResource().GetAttributes()[0].GetValue().GetStringValue()
+		for _, sl := range rl.GetScopeLogs() {
+			c.records = append(c.records, sl.GetLogRecords()...)
+		}
+	}
+}
+
+func TestExporter(t *testing.T) {
+	t.Parallel()
+	c := &collector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	logger := phuslu.NewLogger(io.Discard, nil)
+	cfg := logexport.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = strings.TrimPrefix(server.URL, "http://")
+	cfg.FlushInterval = time.Hour
+	exporter := logexport.NewExporter(cfg, logger)
+	require.NoError(t, exporter.Start(context.Background()))
+
+	logger.With("service", "blockchain").Warn(
+		"Block processed", "slot", 3, "error", io.EOF,
+	)
+	require.NoError(t, exporter.Stop())
+	logger.Info("Not exported")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.Equal(t, "beacond", c.service)
+	var rec *logspb.LogRecord
+	for _, r := range c.records {
+		if r.GetBody().GetStringValue() == "Block processed" {
+			rec = r
+		}
+		require.NotEqual(t, "Not exported", r.GetBody().GetStringValue())
+	}
+	require.NotNil(t, rec)
+	require.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, rec.GetSeverityNumber())
+	attributes := make(map[string]string)
+	for _, kv := range rec.GetAttributes() {
+		attributes[kv.GetKey()] = kv.GetValue().String()
+	}
+	require.Len(t, attributes, 3)
+	require.Contains(t, attributes["service"], "blockchain")
+	require.Contains(t, attributes["slot"], "3")
+	require.Contains(t, attributes["error"], "EOF")
+}
+
+func TestExporterDoesNotExportItsFailures(t *testing.T) {
+	t.Parallel()
+	c := &collector{failures: 1}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	logger := phuslu.NewLogger(io.Discard, nil)
+	cfg := logexport.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = strings.TrimPrefix(server.URL, "http://")
+	cfg.FlushInterval = 10 * time.Millisecond
+	exporter := logexport.NewExporter(cfg, logger)
+	require.NoError(t, exporter.Start(context.Background()))
+
+	logger.Info("Lost")
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.requests > 0
+	}, time.Second, 5*time.Millisecond)
+	logger.Info("Exported")
+	require.NoError(t, exporter.Stop())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bodies := make([]string, 0, len(c.records))
+	for _, r := range c.records {
+		bodies = append(bodies, r.GetBody().GetStringValue())
+	}
+	require.Contains(t, bodies, "Exported")
+	require.NotContains(t, bodies, "Failed to export logs")
+	require.NotContains(t, bodies, "Resumed exporting logs")
+}
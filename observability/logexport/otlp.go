@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logexport
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// scopeName is the instrumentation scope logs are reported under.
+const scopeName = "github.com/berachain/beacon-kit/log"
+
+//nolint:gochecknoglobals // lookup table.
+var severities = map[string]logspb.SeverityNumber{
+	"trace": logspb.SeverityNumber_SEVERITY_NUMBER_TRACE,
+	"debug": logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG,
+	"info":  logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+	"warn":  logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+	"error": logspb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+	"fatal": logspb.SeverityNumber_SEVERITY_NUMBER_FATAL,
+}
+
+// newRequest returns the OTLP export request of the given records.
+func newRequest(
+	serviceName string, records []*phuslu.Record,
+) *collogspb.ExportLogsServiceRequest {
+	logRecords := make([]*logspb.LogRecord, len(records))
+	for i, rec := range records {
+		logRecords[i] = newLogRecord(rec)
+	}
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					stringAttribute("service.name", serviceName),
+				},
+			},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: scopeName},
+				LogRecords: logRecords,
+			}},
+		}},
+	}
+}
+
+// newLogRecord converts a record to an OTLP log record. The fields of the
+// record are sorted by key.
+func newLogRecord(rec *phuslu.Record) *logspb.LogRecord {
+	keys := make([]string, 0, len(rec.Fields))
+	for key := range rec.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attributes := make([]*commonpb.KeyValue, len(keys))
+	for i, key := range keys {
+		attributes[i] = &commonpb.KeyValue{
+			Key: key, Value: anyValue(rec.Fields[key]),
+		}
+	}
+	//#nosec:G115 // timestamps are positive.
+	return &logspb.LogRecord{
+		TimeUnixNano:   uint64(rec.Time.UnixNano()),
+		SeverityNumber: severities[rec.Level],
+		SeverityText:   rec.Level,
+		Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: rec.Message}},
+		Attributes:     attributes,
+	}
+}
+
+// anyValue converts a field value to an OTLP value. Values without an OTLP
+// counterpart are formatted as strings.
+func anyValue(v any) *commonpb.AnyValue {
+	switch v := v.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case error:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Error()}}
+	case fmt.Stringer:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.String()}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
+
+// stringAttribute returns a string OTLP attribute.
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
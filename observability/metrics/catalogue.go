@@ -0,0 +1,590 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+// Kind is the Prometheus type of a metric.
+type Kind uint8
+
+const (
+	// Counter is a monotonically increasing count.
+	Counter Kind = iota
+	// Gauge is a value that can go up and down.
+	Gauge
+	// Histogram is a distribution of durations, in seconds.
+	Histogram
+)
+
+// String returns the Prometheus name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case Counter:
+		return "counter"
+	case Gauge:
+		return "gauge"
+	case Histogram:
+		return "histogram"
+	default:
+		return "unknown"
+	}
+}
+
+//nolint:gochecknoglobals // bucket layouts are shared by the catalogue.
+var (
+	// proofBuckets suit sub-second cryptographic work, e.g. Merkle proofs.
+	proofBuckets = []float64{
+		.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1,
+	}
+	// blockBuckets suit block processing, which is bound by the slot time.
+	blockBuckets = []float64{
+		.005, .01, .025, .05, .1, .25, .5, 1, 2, 4, 8,
+	}
+	// engineBuckets suit Engine API round trips to the execution client.
+	engineBuckets = []float64{
+		.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30,
+	}
+)
+
+// Catalogue is the list of every metric the node emits. Metrics are emitted
+// by key, and a key missing from the catalogue is dropped. Only the labels
+// listed for a metric are kept, so that no metric is labelled by a value
+// such as a slot or a hash that grows the number of series without bound.
+//
+// The catalogue is documented in README.md, which must be kept in sync.
+//
+//nolint:gochecknoglobals // the catalogue is static.
+var Catalogue = []Definition{
+	// Consensus.
+	{
+		Key:     "beacon_kit.runtime.prepare_proposal_duration",
+		Kind:    Histogram,
+		Help:    "Time spent handling PrepareProposal.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.runtime.process_proposal_duration",
+		Kind:    Histogram,
+		Help:    "Time spent handling ProcessProposal.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:    "beacon_kit.runtime.version",
+		Kind:   Gauge,
+		Help:   "Always 1, labelled with the node and execution client versions.",
+		Labels: []string{"version", "system", "eth_version", "eth_name"},
+	},
+	{
+		Key:    "beacon_kit.runtime.version.reported",
+		Kind:   Counter,
+		Help:   "Number of times the node version was reported.",
+		Labels: []string{"version", "system"},
+	},
+
+	// Blockchain.
+	{
+		Key:     "beacon_kit.beacon.blockchain.state_transition_duration",
+		Kind:    Histogram,
+		Help:    "Time spent executing the state transition of a block.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.blockchain.state_root_verification_duration",
+		Kind:    Histogram,
+		Help:    "Time spent verifying the state root of a proposed block.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:  "beacon_kit.blockchain.da_attested_voting_power",
+		Kind: Gauge,
+		Help: "Voting power that attested to data availability in the last commit.",
+	},
+	{
+		Key:  "beacon_kit.blockchain.optimistic_payload_build_success",
+		Kind: Counter,
+		Help: "Payloads built optimistically for the next slot.",
+	},
+	{
+		Key:  "beacon_kit.blockchain.optimistic_payload_build_failure",
+		Kind: Counter,
+		Help: "Failures to build a payload optimistically for the next slot.",
+	},
+	{
+		Key:  "beacon_kit.blockchain.rebuild_payload_for_rejected_block_success",
+		Kind: Counter,
+		Help: "Payloads rebuilt after a proposed block was rejected.",
+	},
+	{
+		Key:  "beacon_kit.blockchain.rebuild_payload_for_rejected_block_failure",
+		Kind: Counter,
+		Help: "Failures to rebuild a payload after a proposed block was rejected.",
+	},
+	{
+		Key:  "beacon_kit.execution.deposit.failed_to_get_block_logs",
+		Kind: Counter,
+		Help: "Failures to read deposit logs of an execution block.",
+	},
+	{
+		Key:  "beacon_kit.execution.deposit.failed_to_enqueue_deposits",
+		Kind: Counter,
+		Help: "Failures to store the deposits read from an execution block.",
+	},
+
+	// Data availability.
+	{
+		Key:     "beacon_kit.da.blob.factory.build_sidecar_duration",
+		Kind:    Histogram,
+		Help:    "Time spent building the blob sidecars of a block.",
+		Labels:  []string{"num_sidecars"},
+		Buckets: proofBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.factory.build_kzg_inclusion_proof_duration",
+		Kind:    Histogram,
+		Help:    "Time spent building the KZG commitment inclusion proof of a sidecar.",
+		Buckets: proofBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.factory.build_block_body_proof_duration",
+		Kind:    Histogram,
+		Help:    "Time spent building the block body proof of a sidecar.",
+		Buckets: proofBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.factory.build_commitment_proof_duration",
+		Kind:    Histogram,
+		Help:    "Time spent building the commitment proof of a sidecar.",
+		Buckets: proofBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.processor.verify_blobs_duration",
+		Kind:    Histogram,
+		Help:    "Time spent verifying the blob sidecars of a block.",
+		Labels:  []string{"num_sidecars"},
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.processor.process_blob_duration",
+		Kind:    Histogram,
+		Help:    "Time spent storing the blob sidecars of a block.",
+		Labels:  []string{"num_sidecars"},
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.verifier.verify_blobs_duration",
+		Kind:    Histogram,
+		Help:    "Time spent verifying blob sidecars end to end.",
+		Labels:  []string{"num_sidecars", "kzg_implementation"},
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.verifier.verify_inclusion_proofs_duration",
+		Kind:    Histogram,
+		Help:    "Time spent verifying the inclusion proofs of blob sidecars.",
+		Labels:  []string{"num_sidecars"},
+		Buckets: proofBuckets,
+	},
+	{
+		Key:     "beacon_kit.da.blob.verifier.verify_kzg_proofs_duration",
+		Kind:    Histogram,
+		Help:    "Time spent verifying the KZG proofs of blob sidecars.",
+		Labels:  []string{"num_sidecars", "kzg_implementation"},
+		Buckets: blockBuckets,
+	},
+
+	// Execution client sync.
+	{
+		Key:  "beacon_kit.el_sync.cl_payload_number",
+		Kind: Gauge,
+		Help: "Execution block number of the latest payload in the beacon state.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.el_head",
+		Kind: Gauge,
+		Help: "Head block number reported by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.el_highest",
+		Kind: Gauge,
+		Help: "Highest block number known to the execution client.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.distance",
+		Kind: Gauge,
+		Help: "Blocks between the beacon state payload and the execution client head.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.el_syncing",
+		Kind: Gauge,
+		Help: "1 if the execution client reports that it is syncing.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.el_offline",
+		Kind: Gauge,
+		Help: "1 if the execution client could not be reached.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.is_optimistic",
+		Kind: Gauge,
+		Help: "1 if the node is following the chain optimistically.",
+	},
+	{
+		Key:  "beacon_kit.el_sync.el_offline_polls",
+		Kind: Counter,
+		Help: "Polls of the execution client that failed.",
+	},
+
+	// Execution client.
+	{
+		Key:     "beacon_kit.execution.client.forkchoice_update_duration",
+		Kind:    Histogram,
+		Help:    "Round trip time of engine_forkchoiceUpdated.",
+		Buckets: engineBuckets,
+	},
+	{
+		Key:     "beacon_kit.execution.client.new_payload_duration",
+		Kind:    Histogram,
+		Help:    "Round trip time of engine_newPayload.",
+		Buckets: engineBuckets,
+	},
+	{
+		Key:     "beacon_kit.execution.client.get_payload_duration",
+		Kind:    Histogram,
+		Help:    "Round trip time of engine_getPayload.",
+		Buckets: engineBuckets,
+	},
+	{
+		Key:  "beacon_kit.execution.client.engine_api_timeout",
+		Kind: Counter,
+		Help: "Engine API calls that timed out.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.forkchoice_update_duration_timeout",
+		Kind: Counter,
+		Help: "engine_forkchoiceUpdated calls that timed out.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.new_payload_duration_timeout",
+		Kind: Counter,
+		Help: "engine_newPayload calls that timed out.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.get_payload_duration_timeout",
+		Kind: Counter,
+		Help: "engine_getPayload calls that timed out.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.http_timeout",
+		Kind: Counter,
+		Help: "HTTP requests to the execution client that timed out.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.parse_error",
+		Kind: Counter,
+		Help: "JSON-RPC parse errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.invalid_request",
+		Kind: Counter,
+		Help: "JSON-RPC invalid request errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.method_not_found",
+		Kind: Counter,
+		Help: "JSON-RPC method not found errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.invalid_params",
+		Kind: Counter,
+		Help: "JSON-RPC invalid params errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.internal_error",
+		Kind: Counter,
+		Help: "JSON-RPC internal errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.unknown_payload_error",
+		Kind: Counter,
+		Help: "Unknown payload errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.invalid_forkchoice_state",
+		Kind: Counter,
+		Help: "Invalid forkchoice state errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.invalid_payload_attributes",
+		Kind: Counter,
+		Help: "Invalid payload attributes errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.request_too_large",
+		Kind: Counter,
+		Help: "Request too large errors returned by the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.client.internal_server_error",
+		Kind: Counter,
+		Help: "HTTP internal server errors returned by the execution client.",
+	},
+
+	// Execution engine.
+	{
+		Key:    "beacon_kit.execution.engine.forkchoice_update",
+		Kind:   Counter,
+		Help:   "Forkchoice updates sent to the execution client.",
+		Labels: []string{"has_payload_attributes"},
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_valid",
+		Kind: Counter,
+		Help: "Forkchoice updates answered with VALID.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_syncing",
+		Kind: Counter,
+		Help: "Forkchoice updates answered with SYNCING.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_invalid",
+		Kind: Counter,
+		Help: "Forkchoice updates answered with INVALID.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_fatal_error",
+		Kind: Counter,
+		Help: "Forkchoice updates that failed with a fatal error.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_non_fatal_error",
+		Kind: Counter,
+		Help: "Forkchoice updates that failed with a non-fatal error.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.forkchoice_update_undefined_error",
+		Kind: Counter,
+		Help: "Forkchoice updates that failed with an unexpected error.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload",
+		Kind: Counter,
+		Help: "Payloads sent to the execution client.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_valid",
+		Kind: Counter,
+		Help: "Payloads answered with VALID.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_accepted_payload_status",
+		Kind: Counter,
+		Help: "Payloads answered with ACCEPTED.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_syncing_payload_status",
+		Kind: Counter,
+		Help: "Payloads answered with SYNCING.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_invalid_payload_status",
+		Kind: Counter,
+		Help: "Payloads answered with INVALID.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_fatal_error",
+		Kind: Counter,
+		Help: "Payloads that failed with a fatal error.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_non_fatal_error",
+		Kind: Counter,
+		Help: "Payloads that failed with a non-fatal error.",
+	},
+	{
+		Key:  "beacon_kit.execution.engine.new_payload_undefined_error",
+		Kind: Counter,
+		Help: "Payloads that failed with an unexpected error.",
+	},
+
+	// Node API.
+	{
+		Key:    "beacon_kit.node_api.cache.hit",
+		Kind:   Counter,
+		Help:   "Node API responses served from the cache.",
+		Labels: []string{"route"},
+	},
+	{
+		Key:    "beacon_kit.node_api.cache.miss",
+		Kind:   Counter,
+		Help:   "Node API responses missing from the cache.",
+		Labels: []string{"route"},
+	},
+	{
+		Key:  "beacon_kit.node_api.cache.size",
+		Kind: Gauge,
+		Help: "Number of cached node API responses.",
+	},
+	{
+		Key:  "beacon_kit.node_api.cache.bytes",
+		Kind: Gauge,
+		Help: "Approximate size in bytes of the cached node API responses.",
+	},
+
+	// Profiling.
+	{
+		Key:    "beacon_kit.profiling.slow_blocks",
+		Kind:   Counter,
+		Help:   "ABCI calls that exceeded the slow block profiling threshold.",
+		Labels: []string{"method"},
+	},
+
+	// State transition.
+	{
+		Key:  "beacon_kit.state.block_tx_gas_used",
+		Kind: Gauge,
+		Help: "Gas used by the transactions of the latest payload.",
+	},
+	{
+		Key:  "beacon_kit.state.block_blob_gas_used",
+		Kind: Gauge,
+		Help: "Blob gas used by the latest payload.",
+	},
+	{
+		Key:  "beacon_kit.state.payload_consensus_timestamp_diff",
+		Kind: Gauge,
+		Help: "Seconds between the latest payload and consensus timestamps.",
+	},
+	{
+		Key:  "beacon_kit.state.partial_withdrawals_enqueued",
+		Kind: Gauge,
+		Help: "Partial withdrawals enqueued in the latest block.",
+	},
+	{
+		Key:  "beacon_kit.state.deposit_stake_lost",
+		Kind: Counter,
+		Help: "Deposits ignored because their signature is invalid.",
+	},
+	{
+		Key:  "beacon_kit.state.partial_withdrawal_request_dropped",
+		Kind: Counter,
+		Help: "Partial withdrawal requests dropped because the queue is full.",
+	},
+	{
+		Key:  "beacon_kit.state.partial_withdrawal_request_invalid",
+		Kind: Counter,
+		Help: "Partial withdrawal requests rejected as invalid.",
+	},
+	{
+		Key:  "beacon_kit.state.validator_not_withdrawable",
+		Kind: Counter,
+		Help: "Validators added without ETH1 withdrawal credentials.",
+	},
+	{
+		Key:  "beacon_kit.statedb.partial_withdrawal_request_invalid",
+		Kind: Counter,
+		Help: "Pending partial withdrawals skipped as invalid.",
+	},
+	{
+		Key:  "beacon_kit.statedb.excess_stake_partial_withdrawal",
+		Kind: Counter,
+		Help: "Withdrawals of stake above the maximum effective balance.",
+	},
+
+	// Validator monitor.
+	{
+		Key:    "beacon_kit.validator_monitor.balance",
+		Kind:   Gauge,
+		Help:   "Balance of the monitored validator, in Gwei.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.effective_balance",
+		Kind:   Gauge,
+		Help:   "Effective balance of the monitored validator, in Gwei.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.withdrawn",
+		Kind:   Gauge,
+		Help:   "Gwei withdrawn to the monitored validator since the node started.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.exiting",
+		Kind:   Gauge,
+		Help:   "1 once the monitored validator has initiated its exit.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.proposals_made",
+		Kind:   Counter,
+		Help:   "Blocks proposed by the monitored validator.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.proposals_missed",
+		Kind:   Counter,
+		Help:   "Blocks CometBFT attributed to the monitored validator that carry another beacon proposer index.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.withdrawals",
+		Kind:   Counter,
+		Help:   "Withdrawals received by the monitored validator.",
+		Labels: []string{"validator"},
+	},
+	{
+		Key:    "beacon_kit.validator_monitor.effective_balance_changes",
+		Kind:   Counter,
+		Help:   "Changes of the effective balance of the monitored validator.",
+		Labels: []string{"validator"},
+	},
+
+	// Validator.
+	{
+		Key:     "beacon_kit.validator.request_block_for_proposal_duration",
+		Kind:    Histogram,
+		Help:    "Time spent building a block to propose.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:     "beacon_kit.validator.state_root_computation_duration",
+		Kind:    Histogram,
+		Help:    "Time spent computing the state root of a block to propose.",
+		Buckets: blockBuckets,
+	},
+	{
+		Key:  "beacon_kit.validator.failed_to_retrieve_payload",
+		Kind: Counter,
+		Help: "Failures to retrieve a payload for a block to propose.",
+	},
+	{
+		Key:    "beacon_kit.validator.consensus_tx_size",
+		Kind:   Gauge,
+		Help:   "Encoded size in bytes of the latest consensus transaction.",
+		Labels: []string{"tx"},
+	},
+	{
+		Key:    "beacon_kit.validator.consensus_tx_compression_ratio",
+		Kind:   Gauge,
+		Help:   "Encoded size of the latest consensus transaction, as a percent of its raw size.",
+		Labels: []string{"tx"},
+	},
+}
@@ -18,16 +18,13 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package components
+package metrics
 
-import (
-	"github.com/berachain/beacon-kit/config/config"
-	"github.com/berachain/beacon-kit/observability/telemetry"
-)
+import "github.com/berachain/beacon-kit/errors"
 
-// ProvideTelemetryService is a function that provides a TelemetrySink.
-func ProvideTelemetryService(
-	cfg *config.Config,
-) (*telemetry.Service, error) {
-	return telemetry.NewService(&cfg.Telemetry)
-}
+var (
+	// ErrDuplicateKey is returned when the catalogue lists a key twice.
+	ErrDuplicateKey = errors.New("duplicate metric key")
+	// ErrUnknownKind is returned when a metric has an unknown kind.
+	ErrUnknownKind = errors.New("unknown metric kind")
+)
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Definition describes a metric of the catalogue.
+type Definition struct {
+	// Key is the key the metric is emitted under.
+	Key string
+	// Kind is the Prometheus type of the metric.
+	Kind Kind
+	// Help describes the metric.
+	Help string
+	// Labels are the names of the labels of the metric. Labels emitted with
+	// any other name are dropped.
+	Labels []string
+	// Buckets are the upper bounds of the histogram buckets, in seconds.
+	Buckets []float64
+}
+
+// Name returns the Prometheus name of the metric: the key with dots replaced
+// by underscores, suffixed with _total for counters and _seconds for
+// histograms.
+func (d Definition) Name() string {
+	name := strings.ReplaceAll(d.Key, ".", "_")
+	switch d.Kind {
+	case Counter:
+		return name + "_total"
+	case Histogram:
+		return name + "_seconds"
+	default:
+		return name
+	}
+}
+
+// Registry is a Prometheus registry holding a collector for every metric of
+// the catalogue, along with the Go runtime and process collectors.
+type Registry struct {
+	registry *prometheus.Registry
+	metrics  map[string]*metric
+	// unknown counts the emissions of keys missing from the catalogue.
+	unknown *prometheus.CounterVec
+}
+
+// metric is a registered metric of the catalogue. Exactly one of the vectors
+// is set, according to the kind of the definition.
+type metric struct {
+	def       Definition
+	counter   *prometheus.CounterVec
+	gauge     *prometheus.GaugeVec
+	histogram *prometheus.HistogramVec
+}
+
+// NewRegistry creates a registry for the metrics of the catalogue.
+func NewRegistry() (*Registry, error) {
+	return newRegistry(Catalogue)
+}
+
+// newRegistry creates a registry for the given definitions.
+func newRegistry(defs []Definition) (*Registry, error) {
+	r := &Registry{
+		registry: prometheus.NewRegistry(),
+		metrics:  make(map[string]*metric, len(defs)),
+		unknown: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "beacon_kit_metrics_unknown_total",
+			Help: "Emissions of metric keys missing from the catalogue.",
+		}, []string{"key"}),
+	}
+	if err := r.registry.Register(r.unknown); err != nil {
+		return nil, err
+	}
+	if err := r.registry.Register(collectors.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := r.registry.Register(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	); err != nil {
+		return nil, err
+	}
+
+	for _, def := range defs {
+		if _, ok := r.metrics[def.Key]; ok {
+			return nil, errors.Wrap(ErrDuplicateKey, def.Key)
+		}
+		m, err := newMetric(def)
+		if err != nil {
+			return nil, err
+		}
+		if err = r.registry.Register(m.collector()); err != nil {
+			return nil, errors.Wrapf(err, "registering %s", def.Key)
+		}
+		r.metrics[def.Key] = m
+	}
+	return r, nil
+}
+
+// newMetric creates the collector of the given definition.
+func newMetric(def Definition) (*metric, error) {
+	m := &metric{def: def}
+	switch def.Kind {
+	case Counter:
+		m.counter = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: def.Name(),
+			Help: def.Help,
+		}, def.Labels)
+	case Gauge:
+		m.gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: def.Name(),
+			Help: def.Help,
+		}, def.Labels)
+	case Histogram:
+		m.histogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    def.Name(),
+			Help:    def.Help,
+			Buckets: def.Buckets,
+		}, def.Labels)
+	default:
+		return nil, errors.Wrap(ErrUnknownKind, def.Key)
+	}
+	return m, nil
+}
+
+// collector returns the collector of the metric.
+func (m *metric) collector() prometheus.Collector {
+	switch {
+	case m.counter != nil:
+		return m.counter
+	case m.gauge != nil:
+		return m.gauge
+	default:
+		return m.histogram
+	}
+}
+
+// labelValues returns the values of the labels of the metric, in order, from
+// the given key-value pairs. Labels missing from the pairs are left empty.
+func (m *metric) labelValues(args []string) []string {
+	values := make([]string, len(m.def.Labels))
+	for i := 0; i+1 < len(args); i += 2 {
+		if idx := slices.Index(m.def.Labels, args[i]); idx >= 0 {
+			values[idx] = args[i+1]
+		}
+	}
+	return values
+}
+
+// lookup returns the metric of the given key and kind, or nil if the
+// catalogue has none.
+func (r *Registry) lookup(key string, kind Kind) *metric {
+	m, ok := r.metrics[key]
+	if !ok || m.def.Kind != kind {
+		r.unknown.WithLabelValues(key).Inc()
+		return nil
+	}
+	return m
+}
+
+// IncrementCounter increments the counter of the given key.
+func (r *Registry) IncrementCounter(key string, args ...string) {
+	if m := r.lookup(key, Counter); m != nil {
+		m.counter.WithLabelValues(m.labelValues(args)...).Inc()
+	}
+}
+
+// SetGauge sets the gauge of the given key to the given value.
+func (r *Registry) SetGauge(key string, value int64, args ...string) {
+	if m := r.lookup(key, Gauge); m != nil {
+		m.gauge.WithLabelValues(m.labelValues(args)...).Set(float64(value))
+	}
+}
+
+// MeasureSince observes the time elapsed since start in the histogram of the
+// given key.
+func (r *Registry) MeasureSince(key string, start time.Time, args ...string) {
+	if m := r.lookup(key, Histogram); m != nil {
+		m.histogram.WithLabelValues(m.labelValues(args)...).Observe(
+			time.Since(start).Seconds(),
+		)
+	}
+}
+
+// Gatherer returns the gatherer of the registered metrics.
+func (r *Registry) Gatherer() prometheus.Gatherer {
+	return r.registry
+}
+
+// Handler returns an HTTP handler serving the registered metrics in the
+// Prometheus exposition format.
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
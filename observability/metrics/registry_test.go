@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/metrics"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+// family returns the gathered metric family of the given name.
+func family(t *testing.T, r *metrics.Registry, name string) *dto.MetricFamily {
+	t.Helper()
+	families, err := r.Gatherer().Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	require.Failf(t, "metric not gathered", "name: %s", name)
+	return nil
+}
+
+func TestCatalogueRegisters(t *testing.T) {
+	t.Parallel()
+	_, err := metrics.NewRegistry()
+	require.NoError(t, err)
+
+	names := make(map[string]struct{}, len(metrics.Catalogue))
+	for _, def := range metrics.Catalogue {
+		require.NotEmpty(t, def.Help, def.Key)
+		if def.Kind == metrics.Histogram {
+			require.NotEmpty(t, def.Buckets, def.Key)
+		}
+		require.NotContains(t, names, def.Name())
+		names[def.Name()] = struct{}{}
+	}
+}
+
+func TestRegistryDropsUndeclaredLabels(t *testing.T) {
+	t.Parallel()
+	r, err := metrics.NewRegistry()
+	require.NoError(t, err)
+
+	key := "beacon_kit.execution.deposit.failed_to_get_block_logs"
+	r.IncrementCounter(key, "block_num", "1")
+	r.IncrementCounter(key, "block_num", "2")
+
+	f := family(t, r, "beacon_kit_execution_deposit_failed_to_get_block_logs_total")
+	require.Equal(t, dto.MetricType_COUNTER, f.GetType())
+	require.Len(t, f.GetMetric(), 1)
+	require.Empty(t, f.GetMetric()[0].GetLabel())
+	require.InDelta(t, 2, f.GetMetric()[0].GetCounter().GetValue(), 0)
+}
+
+func TestRegistryKeepsDeclaredLabels(t *testing.T) {
+	t.Parallel()
+	r, err := metrics.NewRegistry()
+	require.NoError(t, err)
+
+	r.MeasureSince(
+		"beacon_kit.da.blob.verifier.verify_kzg_proofs_duration",
+		time.Now().Add(-20*time.Millisecond),
+		"num_sidecars", "3", "slot", "10",
+	)
+
+	f := family(t, r, "beacon_kit_da_blob_verifier_verify_kzg_proofs_duration_seconds")
+	require.Equal(t, dto.MetricType_HISTOGRAM, f.GetType())
+	require.Len(t, f.GetMetric(), 1)
+	labels := f.GetMetric()[0].GetLabel()
+	require.Len(t, labels, 2)
+	require.Equal(t, "kzg_implementation", labels[0].GetName())
+	require.Empty(t, labels[0].GetValue())
+	require.Equal(t, "num_sidecars", labels[1].GetName())
+	require.Equal(t, "3", labels[1].GetValue())
+
+	h := f.GetMetric()[0].GetHistogram()
+	require.Equal(t, uint64(1), h.GetSampleCount())
+	require.GreaterOrEqual(t, h.GetSampleSum(), 0.02)
+}
+
+func TestRegistryCountsUnknownKeys(t *testing.T) {
+	t.Parallel()
+	r, err := metrics.NewRegistry()
+	require.NoError(t, err)
+
+	r.IncrementCounter("beacon_kit.not_in_catalogue")
+	// A known key emitted as the wrong kind is dropped too.
+	r.SetGauge("beacon_kit.state.deposit_stake_lost", 1)
+
+	f := family(t, r, "beacon_kit_metrics_unknown_total")
+	require.Len(t, f.GetMetric(), 2)
+}
+
+func TestRegistryHandler(t *testing.T) {
+	t.Parallel()
+	r, err := metrics.NewRegistry()
+	require.NoError(t, err)
+	r.SetGauge("beacon_kit.node_api.cache.size", 42)
+
+	srv := httptest.NewServer(r.Handler())
+	defer srv.Close()
+	res, err := http.Get(srv.URL) //nolint:noctx // test.
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Contains(t, string(body), "beacon_kit_node_api_cache_size 42")
+	require.Contains(t, string(body), "go_goroutines")
+}
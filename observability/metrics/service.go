@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read the headers of a request.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout bounds the time spent on in-flight scrapes on stop.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Service serves the metrics of a registry to Prometheus.
+type Service struct {
+	cfg      Config
+	logger   log.Logger
+	registry *Registry
+	// srv is nil until the service is started, and when it is disabled.
+	srv *http.Server
+}
+
+// NewService creates a new metrics service.
+func NewService(cfg Config, logger log.Logger, registry *Registry) *Service {
+	return &Service{cfg: cfg, logger: logger, registry: registry}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "metrics"
+}
+
+// Start serves the metrics at /metrics on the configured address.
+func (s *Service) Start(context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", s.registry.Handler())
+	s.srv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		serveErr := s.srv.Serve(listener)
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Metrics server stopped", "error", serveErr)
+		}
+	}()
+	s.logger.Info("Serving metrics", "address", listener.Addr().String())
+	return nil
+}
+
+// Stop stops serving metrics.
+func (s *Service) Stop() error {
+	if s.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.srv.Shutdown(ctx)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling
+
+import "time"
+
+const (
+	defaultSlowBlockThreshold = time.Second
+	defaultMaxFiles           = 30
+)
+
+// DefaultConfig returns the default slow block profiling configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:            false,
+		SlowBlockThreshold: defaultSlowBlockThreshold,
+		Dir:                "",
+		MaxFiles:           defaultMaxFiles,
+	}
+}
+
+// Config is the configuration for capturing profiles of slow blocks.
+type Config struct {
+	// Enabled determines if profiles of slow blocks are captured.
+	Enabled bool `mapstructure:"enabled"`
+	// SlowBlockThreshold is the duration of FinalizeBlock or ProcessProposal
+	// past which profiles are captured.
+	SlowBlockThreshold time.Duration `mapstructure:"slow-block-threshold"`
+	// Dir is the directory profiles are written to. Defaults to
+	// data/profiles in the home directory if empty.
+	Dir string `mapstructure:"dir"`
+	// MaxFiles is the maximum number of profiles kept in Dir. The oldest
+	// profiles are removed past it.
+	MaxFiles int `mapstructure:"max-files"`
+}
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// maxCPUDuration bounds the duration of a CPU profile, should the
+	// profiled call hang.
+	maxCPUDuration = 30 * time.Second
+	// profileExt is the extension of the profile files.
+	profileExt = ".pprof"
+)
+
+// Profiler captures profiles of the blocks that take over a threshold to
+// process. Once a call exceeds the threshold, it captures a goroutine
+// profile and profiles the CPU until the call returns, then captures a heap
+// profile. Profiles are written to a directory keeping the latest files.
+type Profiler struct {
+	cfg    Config
+	logger log.Logger
+	sink   TelemetrySink
+	// capturing is true while profiles are captured, as a single CPU profile
+	// may be recorded at a time.
+	capturing atomic.Bool
+	// mu serializes the writes and rotation of the profile files.
+	mu sync.Mutex
+}
+
+// NewProfiler creates a profiler writing profiles to the directory of the
+// config, which is created if missing.
+func NewProfiler(
+	cfg Config, logger log.Logger, sink TelemetrySink,
+) (*Profiler, error) {
+	if cfg.Enabled {
+		if err := os.MkdirAll(cfg.Dir, 0o700); err != nil {
+			return nil, err
+		}
+	}
+	return &Profiler{cfg: cfg, logger: logger, sink: sink}, nil
+}
+
+// Track tracks a call of the given ABCI method processing the block at the
+// given slot, capturing profiles if it exceeds the threshold. The returned
+// function must be called once the call returns.
+func (p *Profiler) Track(method string, slot math.Slot) func() {
+	if !p.cfg.Enabled {
+		return func() {}
+	}
+	c := &capture{profiler: p, method: method, slot: slot}
+	timer := time.AfterFunc(p.cfg.SlowBlockThreshold, c.start)
+	return func() {
+		if !timer.Stop() {
+			c.stop()
+		}
+	}
+}
+
+// capture holds the profiles captured for a slow call.
+type capture struct {
+	profiler *Profiler
+	method   string
+	slot     math.Slot
+
+	// mu protects the fields below, start and stop may run concurrently.
+	mu sync.Mutex
+	// started is true once profiles are being captured.
+	started bool
+	// stopped is true once the call returned.
+	stopped bool
+	// prefix is the prefix of the file names of the profiles.
+	prefix  string
+	cpu     bytes.Buffer
+	cpuStop *time.Timer
+}
+
+// start captures the goroutine profile and starts profiling the CPU, once
+// the call exceeds the threshold.
+func (c *capture) start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		// The call returned as the threshold was reached.
+		return
+	}
+
+	p := c.profiler
+	p.sink.IncrementCounter("beacon_kit.profiling.slow_blocks", "method", c.method)
+	if !p.capturing.CompareAndSwap(false, true) {
+		p.logger.Warn(
+			"Skipping profiles of slow block, profiles are being captured",
+			"method", c.method, "slot", c.slot,
+		)
+		return
+	}
+	p.logger.Warn(
+		"Block is slow to process, capturing profiles",
+		"method", c.method, "slot", c.slot, "threshold", p.cfg.SlowBlockThreshold,
+	)
+
+	c.started = true
+	c.prefix = fmt.Sprintf(
+		"%s-slot-%d-%d", strings.ToLower(c.method), c.slot, time.Now().UnixMilli(),
+	)
+	p.write(c.name("goroutine"), func(buf *bytes.Buffer) error {
+		return pprof.Lookup("goroutine").WriteTo(buf, 0)
+	})
+	if err := pprof.StartCPUProfile(&c.cpu); err != nil {
+		p.logger.Warn("Failed to start CPU profile", "error", err)
+		return
+	}
+	c.cpuStop = time.AfterFunc(maxCPUDuration, c.stop)
+}
+
+// stop stops profiling the CPU and captures the heap profile, once the call
+// returns or the CPU profile reaches its maximum duration.
+func (c *capture) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return
+	}
+	c.stopped = true
+	if !c.started {
+		return
+	}
+	p := c.profiler
+	defer p.capturing.Store(false)
+
+	if c.cpuStop != nil {
+		c.cpuStop.Stop()
+		pprof.StopCPUProfile()
+		p.write(c.name("cpu"), func(buf *bytes.Buffer) error {
+			_, err := buf.Write(c.cpu.Bytes())
+			return err
+		})
+	}
+	p.write(c.name("heap"), func(buf *bytes.Buffer) error {
+		return pprof.Lookup("heap").WriteTo(buf, 0)
+	})
+}
+
+// name returns the file name of the profile of the given kind.
+func (c *capture) name(kind string) string {
+	return c.prefix + "-" + kind + profileExt
+}
+
+// write writes the profile produced by profile to the file with the given
+// name, then removes the oldest profiles past the maximum number of files.
+func (p *Profiler) write(name string, profile func(*bytes.Buffer) error) {
+	var buf bytes.Buffer
+	if err := profile(&buf); err != nil {
+		p.logger.Error("Failed to capture profile", "file", name, "error", err)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	path := filepath.Join(p.cfg.Dir, name)
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		p.logger.Error("Failed to write profile", "file", path, "error", err)
+		return
+	}
+	p.logger.Info("Wrote profile", "file", path)
+	if err := p.rotate(); err != nil {
+		p.logger.Error("Failed to remove old profiles", "error", err)
+	}
+}
+
+// rotate removes the oldest profiles past the maximum number of files.
+func (p *Profiler) rotate() error {
+	if p.cfg.MaxFiles <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(p.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	type profileFile struct {
+		name    string
+		modTime time.Time
+	}
+	var files []profileFile
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != profileExt {
+			continue
+		}
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			return infoErr
+		}
+		files = append(files, profileFile{entry.Name(), info.ModTime()})
+	}
+	if len(files) <= p.cfg.MaxFiles {
+		return nil
+	}
+	slices.SortFunc(files, func(a, b profileFile) int {
+		return a.modTime.Compare(b.modTime)
+	})
+	for _, f := range files[:len(files)-p.cfg.MaxFiles] {
+		if err = os.Remove(filepath.Join(p.cfg.Dir, f.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	"github.com/stretchr/testify/require"
+)
+
+func newProfiler(t *testing.T, maxFiles int) (*profiling.Profiler, string) {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "profiles")
+	cfg := profiling.DefaultConfig()
+	cfg.Enabled = true
+	cfg.SlowBlockThreshold = 10 * time.Millisecond
+	cfg.Dir = dir
+	cfg.MaxFiles = maxFiles
+	p, err := profiling.NewProfiler(
+		cfg, noop.NewLogger[any](), metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, err)
+	return p, dir
+}
+
+func profileFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// The tests are not parallel as a single CPU profile may run at a time.
+
+func TestProfilerFastBlock(t *testing.T) {
+	p, dir := newProfiler(t, 30)
+	done := p.Track("FinalizeBlock", 7)
+	done()
+	time.Sleep(30 * time.Millisecond)
+	require.Empty(t, profileFiles(t, dir))
+}
+
+func TestProfilerSlowBlock(t *testing.T) {
+	p, dir := newProfiler(t, 30)
+	done := p.Track("FinalizeBlock", 7)
+	time.Sleep(50 * time.Millisecond)
+	done()
+
+	files := profileFiles(t, dir)
+	require.Len(t, files, 3)
+	for i, kind := range []string{"cpu", "goroutine", "heap"} {
+		require.Regexp(t, `^finalizeblock-slot-7-\d+-`+kind+`\.pprof$`, files[i])
+		info, err := os.Stat(filepath.Join(dir, files[i]))
+		require.NoError(t, err)
+		require.NotZero(t, info.Size())
+	}
+}
+
+func TestProfilerRotation(t *testing.T) {
+	p, dir := newProfiler(t, 4)
+	for range 3 {
+		done := p.Track("ProcessProposal", 10)
+		time.Sleep(30 * time.Millisecond)
+		done()
+	}
+	require.Len(t, profileFiles(t, dir), 4)
+}
+
+func TestProfilerDisabled(t *testing.T) {
+	cfg := profiling.DefaultConfig()
+	cfg.SlowBlockThreshold = time.Nanosecond
+	cfg.Dir = filepath.Join(t.TempDir(), "profiles")
+	p, err := profiling.NewProfiler(
+		cfg, noop.NewLogger[any](), metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, err)
+	done := p.Track("FinalizeBlock", 1)
+	time.Sleep(10 * time.Millisecond)
+	done()
+	_, err = os.Stat(cfg.Dir)
+	require.True(t, os.IsNotExist(err))
+}
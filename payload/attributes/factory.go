@@ -24,6 +24,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -35,8 +36,14 @@ type Factory struct {
 	// logger is the logger for the attributes factory.
 	logger log.Logger
 	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
+	// the execution client for the payload build, unless one is registered
+	// for the proposer.
 	suggestedFeeRecipient common.ExecutionAddress
+	// feeRecipients holds the fee recipients registered for validators.
+	feeRecipients FeeRecipients
+	// proposerPubkey is the public key of the validator proposing the
+	// payloads built by this node.
+	proposerPubkey crypto.BLSPubkey
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -44,11 +51,15 @@ func NewAttributesFactory(
 	chainSpec ChainSpec,
 	logger log.Logger,
 	suggestedFeeRecipient common.ExecutionAddress,
+	feeRecipients FeeRecipients,
+	proposerPubkey crypto.BLSPubkey,
 ) *Factory {
 	return &Factory{
 		chainSpec:             chainSpec,
 		logger:                logger,
 		suggestedFeeRecipient: suggestedFeeRecipient,
+		feeRecipients:         feeRecipients,
+		proposerPubkey:        proposerPubkey,
 	}
 }
 
@@ -87,8 +98,23 @@ func (f *Factory) BuildPayloadAttributes(
 		f.chainSpec.ActiveForkVersionForTimestamp(timestamp),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.feeRecipient(st),
 		withdrawals,
 		prevHeadRoot,
 	)
 }
+
+// feeRecipient returns the fee recipient registered for the proposer, falling
+// back to the suggested fee recipient.
+func (f *Factory) feeRecipient(st *statedb.StateDB) common.ExecutionAddress {
+	// The proposer may not be a validator yet, in which case only the fee
+	// recipients registered by public key apply.
+	var index *math.ValidatorIndex
+	if idx, err := st.ValidatorIndexByPubkey(f.proposerPubkey); err == nil {
+		index = &idx
+	}
+	if addr, ok := f.feeRecipients.FeeRecipient(index, f.proposerPubkey); ok {
+		return addr
+	}
+	return f.suggestedFeeRecipient
+}
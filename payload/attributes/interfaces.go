@@ -22,6 +22,7 @@ package attributes
 
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -30,3 +31,10 @@ type ChainSpec interface {
 	EpochsPerHistoricalVector() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 }
+
+// FeeRecipients holds the fee recipients registered for validators.
+type FeeRecipients interface {
+	// FeeRecipient returns the fee recipient registered for the validator of
+	// the given public key, and of the given index if it is known.
+	FeeRecipient(index *math.ValidatorIndex, pubkey crypto.BLSPubkey) (common.ExecutionAddress, bool)
+}
@@ -39,6 +39,10 @@ type Config struct {
 	// SuggestedFeeRecipient is the address that will receive the transaction
 	// fees produced by any blocks from this node.
 	SuggestedFeeRecipient common.ExecutionAddress `mapstructure:"suggested-fee-recipient"`
+	// FeeRecipientsFile is the path to a JSON file mapping validators, by
+	// public key or index, to the fee recipient of the blocks they propose.
+	// They override the suggested fee recipient.
+	FeeRecipientsFile string `mapstructure:"fee-recipients-file"`
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
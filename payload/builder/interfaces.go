@@ -33,7 +33,10 @@ import (
 
 type PayloadCache interface {
 	GetAndEvict(slot math.Slot, stateRoot common.Root) (cache.PayloadIDCacheResult, bool)
-	Set(slot math.Slot, stateRoot common.Root, pid engineprimitives.PayloadID, version common.Version)
+	Set(
+		slot math.Slot, stateRoot common.Root, pid engineprimitives.PayloadID,
+		version common.Version, feeRecipient common.ExecutionAddress,
+	)
 }
 
 // AttributesFactory is the interface for the attributes factory.
@@ -63,4 +66,5 @@ type ExecutionEngine interface {
 
 type ChainSpec interface {
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	BlobCellProofs(forkVersion common.Version) bool
 }
@@ -85,7 +85,7 @@ func (pb *PayloadBuilder) RequestPayloadAsync(
 
 	// Only add to cache if we received back a payload ID.
 	if payloadID != nil {
-		pb.pc.Set(slot, parentBlockRoot, *payloadID, forkVersion)
+		pb.pc.Set(slot, parentBlockRoot, *payloadID, forkVersion, attrs.GetSuggestedFeeRecipient())
 	}
 
 	return payloadID, forkVersion, nil
@@ -171,12 +171,12 @@ func (pb *PayloadBuilder) RetrievePayload(
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
 	payload := envelope.GetExecutionPayload()
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	if payload.GetFeeRecipient() != payloadID.FeeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", payloadID.FeeRecipient,
 		)
 	}
 
@@ -205,6 +205,7 @@ func (pb *PayloadBuilder) getPayload(
 		&ctypes.GetPayloadRequest{
 			PayloadID:   payloadID,
 			ForkVersion: forkVersion,
+			CellProofs:  pb.chainSpec.BlobCellProofs(forkVersion),
 		},
 	)
 	if err != nil {
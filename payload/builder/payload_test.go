@@ -106,7 +106,7 @@ func TestRetrievePayloadSunnyPath(t *testing.T) {
 	)
 
 	// set expectations
-	cache.Set(slot, parentBlockRoot, dummyPayloadID, version.Deneb())
+	cache.Set(slot, parentBlockRoot, dummyPayloadID, version.Deneb(), common.ExecutionAddress{})
 	ee.payloadEnvToReturn = expectedPayload
 
 	// test and checks
@@ -154,7 +154,7 @@ func TestRetrievePayloadNilWithdrawalsListRejected(t *testing.T) {
 	)
 
 	// set expectations
-	cache.Set(slot, parentBlockRoot, dummyPayloadID, version.Deneb())
+	cache.Set(slot, parentBlockRoot, dummyPayloadID, version.Deneb(), common.ExecutionAddress{})
 	ee.payloadEnvToReturn = faultyPayload
 
 	// test and checks
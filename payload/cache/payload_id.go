@@ -21,6 +21,8 @@
 package cache
 
 import (
+	"cmp"
+	"slices"
 	"sync"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -53,6 +55,8 @@ type payloadIDCacheKey struct {
 type PayloadIDCacheResult struct {
 	PayloadID   engineprimitives.PayloadID
 	ForkVersion common.Version
+	// FeeRecipient is the suggested fee recipient the payload was built for.
+	FeeRecipient common.ExecutionAddress
 }
 
 // NewPayloadIDCache initializes and returns a new instance of PayloadIDCache.
@@ -97,12 +101,14 @@ func (p *PayloadIDCache) GetAndEvict(
 	return pid, true
 }
 
-// Set updates or inserts a payload ID for a given slot and eth1 hash.
+// Set updates or inserts a payload ID for a given slot and eth1 hash, along
+// with the fork version and suggested fee recipient it was built for.
 // It also prunes entries in the cache that are older than the
 // historicalPayloadIDCacheSize limit.
 func (p *PayloadIDCache) Set(
 	slot math.Slot, blockRoot common.Root,
 	pid engineprimitives.PayloadID, version common.Version,
+	feeRecipient common.ExecutionAddress,
 ) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -114,11 +120,41 @@ func (p *PayloadIDCache) Set(
 
 	// Update the cache with the new payload ID.
 	p.slotToBlockRootToPayloadID[payloadIDCacheKey{slot, blockRoot}] = PayloadIDCacheResult{
-		PayloadID:   pid,
-		ForkVersion: version,
+		PayloadID:    pid,
+		ForkVersion:  version,
+		FeeRecipient: feeRecipient,
 	}
 }
 
+// PayloadIDCacheEntry is a payload ID stored in the cache, along with the
+// slot and parent block root it was requested for.
+type PayloadIDCacheEntry struct {
+	PayloadIDCacheResult
+	Slot       math.Slot
+	ParentRoot common.Root
+}
+
+// Entries returns a snapshot of the payload IDs in the cache, ordered by slot.
+func (p *PayloadIDCache) Entries() []PayloadIDCacheEntry {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	entries := make([]PayloadIDCacheEntry, 0, len(p.slotToBlockRootToPayloadID))
+	for key, result := range p.slotToBlockRootToPayloadID {
+		entries = append(entries, PayloadIDCacheEntry{
+			PayloadIDCacheResult: result,
+			Slot:                 key.slot,
+			ParentRoot:           key.root,
+		})
+	}
+	slices.SortFunc(entries, func(a, b PayloadIDCacheEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Slot, b.Slot),
+			slices.Compare(a.ParentRoot[:], b.ParentRoot[:]),
+		)
+	})
+	return entries
+}
+
 // prunePrior removes payload IDs from the cache for slots less than
 // the specified slot. This method helps in managing the memory usage
 // of the cache by discarding outdated entries.
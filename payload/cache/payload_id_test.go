@@ -25,6 +25,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/payload/cache"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
@@ -45,7 +46,7 @@ func TestPayloadIDCache(t *testing.T) {
 		slot := math.Slot(1234)
 		r := [32]byte{1, 2, 3}
 		pid := engineprimitives.PayloadID{1, 2, 3, 3, 7, 8, 7, 8}
-		cacheUnderTest.Set(slot, r, pid, version.Deneb())
+		cacheUnderTest.Set(slot, r, pid, version.Deneb(), common.ExecutionAddress{})
 
 		p, ok := cacheUnderTest.GetAndEvict(slot, r)
 		require.True(t, ok)
@@ -56,7 +57,7 @@ func TestPayloadIDCache(t *testing.T) {
 		slot := math.Slot(1234)
 		r := [32]byte{1, 2, 3}
 		newPid := engineprimitives.PayloadID{9, 9, 9, 9, 9, 9, 9, 9}
-		cacheUnderTest.Set(slot, r, newPid, version.Deneb())
+		cacheUnderTest.Set(slot, r, newPid, version.Deneb(), common.ExecutionAddress{})
 
 		p, ok := cacheUnderTest.GetAndEvict(slot, r)
 		require.True(t, ok)
@@ -68,13 +69,13 @@ func TestPayloadIDCache(t *testing.T) {
 		r := [32]byte{4, 5, 6}
 		pid := engineprimitives.PayloadID{4, 5, 6, 6, 9, 0, 9, 0}
 		// Set pid for slot.
-		cacheUnderTest.Set(slot, r, pid, version.Deneb())
+		cacheUnderTest.Set(slot, r, pid, version.Deneb(), common.ExecutionAddress{})
 
 		// Set historicalPayloadIDCacheSize+1 number of pids. This should
 		// prune the first slot from the cache.
-		cacheUnderTest.Set(slot+1, r, pid, version.Deneb())
-		cacheUnderTest.Set(slot+2, r, pid, version.Deneb())
-		cacheUnderTest.Set(slot+3, r, pid, version.Deneb())
+		cacheUnderTest.Set(slot+1, r, pid, version.Deneb(), common.ExecutionAddress{})
+		cacheUnderTest.Set(slot+2, r, pid, version.Deneb(), common.ExecutionAddress{})
+		cacheUnderTest.Set(slot+3, r, pid, version.Deneb(), common.ExecutionAddress{})
 
 		// Attempt to retrieve pruned slot.
 		ok := cacheUnderTest.Has(slot, r)
@@ -91,7 +92,7 @@ func TestPayloadIDCache(t *testing.T) {
 			pid := [8]byte{
 				i, i, i, i, i, i, i, i,
 			}
-			cacheUnderTest.Set(slot, r, pid, version.Deneb())
+			cacheUnderTest.Set(slot, r, pid, version.Deneb(), common.ExecutionAddress{})
 		}
 
 		// Only the last historicalPayloadIDCacheSize+1 number of entries
@@ -110,4 +111,25 @@ func TestPayloadIDCache(t *testing.T) {
 			require.True(t, ok, "Expected entry to exist for slot", slot)
 		}
 	})
+	t.Run("Entries", func(t *testing.T) {
+		c := cache.NewPayloadIDCache()
+		require.Empty(t, c.Entries())
+
+		r1, r2 := common.Root{2}, common.Root{1}
+		pid := engineprimitives.PayloadID{1, 2, 3}
+		feeRecipient := common.ExecutionAddress{4, 5, 6}
+		c.Set(11, r1, pid, version.Deneb(), feeRecipient)
+		c.Set(10, r1, pid, version.Deneb(), feeRecipient)
+		c.Set(11, r2, pid, version.Deneb(), feeRecipient)
+
+		entries := c.Entries()
+		require.Len(t, entries, 3)
+		require.Equal(t, math.Slot(10), entries[0].Slot)
+		require.Equal(t, r1, entries[0].ParentRoot)
+		require.Equal(t, math.Slot(11), entries[1].Slot)
+		require.Equal(t, r2, entries[1].ParentRoot)
+		require.Equal(t, r1, entries[2].ParentRoot)
+		require.Equal(t, pid, entries[2].PayloadID)
+		require.Equal(t, feeRecipient, entries[2].FeeRecipient)
+	})
 }
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// entries are the fee recipients of validators, keyed either by index or by
+// public key.
+type entries struct {
+	byIndex  map[math.ValidatorIndex]common.ExecutionAddress
+	byPubkey map[crypto.BLSPubkey]common.ExecutionAddress
+}
+
+func newEntries() entries {
+	return entries{
+		byIndex:  make(map[math.ValidatorIndex]common.ExecutionAddress),
+		byPubkey: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+	}
+}
+
+// get returns the fee recipient of the validator, looked up by index first.
+func (e entries) get(
+	index *math.ValidatorIndex, pubkey crypto.BLSPubkey,
+) (common.ExecutionAddress, bool) {
+	if index != nil {
+		if addr, ok := e.byIndex[*index]; ok {
+			return addr, true
+		}
+	}
+	addr, ok := e.byPubkey[pubkey]
+	return addr, ok
+}
+
+// readFile reads the fee recipients from a JSON file mapping validator ids,
+// either a hex encoded public key or a decimal index, to fee recipients. A
+// missing file holds no fee recipients.
+func readFile(path string) (entries, error) {
+	e := newEntries()
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return e, nil
+	}
+	if err != nil {
+		return e, err
+	}
+
+	var raw map[string]string
+	if err = json.Unmarshal(bz, &raw); err != nil {
+		return e, fmt.Errorf("failed to decode fee recipients file %s: %w", path, err)
+	}
+	for id, recipient := range raw {
+		var addr common.ExecutionAddress
+		if err = addr.UnmarshalText([]byte(recipient)); err != nil {
+			return e, fmt.Errorf("%w for validator %s: %s", ErrInvalidFeeRecipient, id, recipient)
+		}
+		if strings.HasPrefix(id, "0x") {
+			var pubkey crypto.BLSPubkey
+			if err = pubkey.UnmarshalText([]byte(id)); err != nil {
+				return e, fmt.Errorf("%w: %s", ErrInvalidValidatorID, id)
+			}
+			e.byPubkey[pubkey] = addr
+			continue
+		}
+		index, errIndex := math.U64FromString(id)
+		if errIndex != nil {
+			return e, fmt.Errorf("%w: %s", ErrInvalidValidatorID, id)
+		}
+		e.byIndex[index] = addr
+	}
+	return e, nil
+}
+
+// writeFile atomically writes the fee recipients keyed by index to the file,
+// in the format read by readFile.
+func writeFile(path string, byIndex map[math.ValidatorIndex]common.ExecutionAddress) error {
+	raw := make(map[string]string, len(byIndex))
+	for index, addr := range byIndex {
+		raw[index.Base10()] = addr.String()
+	}
+	bz, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	//#nosec:G301 // the data directory is shared with the other stores.
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
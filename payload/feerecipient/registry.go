@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Preparation is the fee recipient registered for a validator, as received
+// by the prepare_beacon_proposer endpoint.
+type Preparation struct {
+	ValidatorIndex math.ValidatorIndex
+	FeeRecipient   common.ExecutionAddress
+}
+
+// Registry holds the fee recipients of validators proposing through this node.
+// Fee recipients come from a static file, keyed by validator index or public
+// key, and from the prepare_beacon_proposer endpoint, keyed by validator
+// index. The latter take precedence and are persisted across restarts.
+type Registry struct {
+	// mu protects the registered fee recipients.
+	mu sync.RWMutex
+	// static are the fee recipients read from the static file.
+	static entries
+	// prepared are the fee recipients registered through the API.
+	prepared entries
+	// path is the file the registered fee recipients are persisted to.
+	path string
+}
+
+// NewRegistry creates a registry holding the fee recipients of the static file
+// and those previously persisted to the given path. An empty static file path
+// disables the static fee recipients.
+func NewRegistry(staticFile, path string) (*Registry, error) {
+	static := newEntries()
+	if staticFile != "" {
+		var err error
+		if static, err = readFile(staticFile); err != nil {
+			return nil, err
+		}
+	}
+	prepared, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{
+		static:   static,
+		prepared: prepared,
+		path:     path,
+	}, nil
+}
+
+// Prepare registers the fee recipients of the given validators and persists
+// them. Registering a new fee recipient for a validator overrides the previous
+// one.
+func (r *Registry) Prepare(preparations []Preparation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	byIndex := make(map[math.ValidatorIndex]common.ExecutionAddress, len(r.prepared.byIndex))
+	for index, addr := range r.prepared.byIndex {
+		byIndex[index] = addr
+	}
+	for _, p := range preparations {
+		byIndex[p.ValidatorIndex] = p.FeeRecipient
+	}
+	if err := writeFile(r.path, byIndex); err != nil {
+		return err
+	}
+	r.prepared.byIndex = byIndex
+	return nil
+}
+
+// FeeRecipient returns the fee recipient registered for the validator of the
+// given public key, and of the given index if it is known.
+func (r *Registry) FeeRecipient(
+	index *math.ValidatorIndex, pubkey crypto.BLSPubkey,
+) (common.ExecutionAddress, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if addr, ok := r.prepared.get(index, pubkey); ok {
+		return addr, true
+	}
+	return r.static.get(index, pubkey)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/payload/feerecipient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	var (
+		dir        = t.TempDir()
+		staticFile = filepath.Join(dir, "fee-recipients.json")
+		path       = filepath.Join(dir, "data", "fee_recipients.json")
+
+		pubkey     = crypto.BLSPubkey{0x01}
+		otherKey   = crypto.BLSPubkey{0x02}
+		index      = math.ValidatorIndex(3)
+		byPubkey   = common.ExecutionAddress{0x0a}
+		byIndex    = common.ExecutionAddress{0x0b}
+		registered = common.ExecutionAddress{0x0c}
+	)
+	static := `{"` + pubkey.String() + `": "` + byPubkey.String() + `", "3": "` + byIndex.String() + `"}`
+	require.NoError(t, os.WriteFile(staticFile, []byte(static), 0o600))
+
+	r, err := feerecipient.NewRegistry(staticFile, path)
+	require.NoError(t, err)
+
+	// The index takes precedence over the public key.
+	addr, ok := r.FeeRecipient(&index, pubkey)
+	require.True(t, ok)
+	require.Equal(t, byIndex, addr)
+	addr, ok = r.FeeRecipient(nil, pubkey)
+	require.True(t, ok)
+	require.Equal(t, byPubkey, addr)
+	_, ok = r.FeeRecipient(nil, otherKey)
+	require.False(t, ok)
+
+	// Registered fee recipients override the static ones and are persisted.
+	require.NoError(t, r.Prepare([]feerecipient.Preparation{
+		{ValidatorIndex: index, FeeRecipient: registered},
+	}))
+	addr, ok = r.FeeRecipient(&index, otherKey)
+	require.True(t, ok)
+	require.Equal(t, registered, addr)
+
+	r, err = feerecipient.NewRegistry("", path)
+	require.NoError(t, err)
+	addr, ok = r.FeeRecipient(&index, otherKey)
+	require.True(t, ok)
+	require.Equal(t, registered, addr)
+	_, ok = r.FeeRecipient(nil, pubkey)
+	require.False(t, ok)
+}
+
+func TestRegistryInvalidFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for name, tc := range map[string]struct {
+		content string
+		err     error
+	}{
+		"invalid validator id":  {`{"validator": "0x0000000000000000000000000000000000000001"}`, feerecipient.ErrInvalidValidatorID},
+		"invalid pubkey":        {`{"0x01": "0x0000000000000000000000000000000000000001"}`, feerecipient.ErrInvalidValidatorID},
+		"invalid fee recipient": {`{"1": "0x01"}`, feerecipient.ErrInvalidFeeRecipient},
+	} {
+		staticFile := filepath.Join(dir, name+".json")
+		require.NoError(t, os.WriteFile(staticFile, []byte(tc.content), 0o600))
+		_, err := feerecipient.NewRegistry(staticFile, filepath.Join(dir, "fee_recipients.json"))
+		require.ErrorIs(t, err, tc.err, name)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip7594
+
+import (
+	"github.com/berachain/beacon-kit/primitives/bytes"
+)
+
+const (
+	// CellsPerExtBlob is the number of cells of a blob extended with its
+	// erasure code.
+	CellsPerExtBlob = 128
+	// FieldElementsPerCell is the number of field elements in a cell.
+	FieldElementsPerCell = 64
+	// BytesPerCell is the size of a cell in bytes.
+	BytesPerCell = FieldElementsPerCell * 32
+)
+
+// Cell represents an EIP-7594 cell of an extended blob.
+type Cell [BytesPerCell]byte
+
+// UnmarshalJSON parses a cell in hex syntax.
+func (c *Cell) UnmarshalJSON(input []byte) error {
+	return bytes.UnmarshalFixedJSON(input, c[:])
+}
+
+// MarshalText returns the hex representation of c.
+func (c Cell) MarshalText() ([]byte, error) {
+	return bytes.Bytes(c[:]).MarshalText()
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package envelope implements the versioned compressed envelope wrapping the
+// SSZ encoded consensus transactions of CometBFT blocks once the fork
+// enabling it is active.
+package envelope
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/snappy"
+)
+
+const (
+	// VersionSnappy marks a consensus transaction compressed with the
+	// snappy block format.
+	VersionSnappy byte = 0x01
+
+	// MaxDecodedTxSize is the upper bound on the size of a decoded consensus
+	// transaction, whatever the consensus params.
+	MaxDecodedTxSize = cmttypes.MaxBlockSizeBytes
+)
+
+// MaxTxSize returns the maximum size of a decoded consensus transaction given
+// the block MaxBytes of the CometBFT consensus params. A transaction never
+// decodes to more than what fits raw in a block. A non positive maxBlockBytes
+// means the CometBFT maximum.
+func MaxTxSize(maxBlockBytes int64) int64 {
+	if maxBlockBytes <= 0 || maxBlockBytes > MaxDecodedTxSize {
+		return MaxDecodedTxSize
+	}
+	return maxBlockBytes
+}
+
+// EncodeTx wraps the SSZ encoded consensus transaction bz in the versioned
+// compressed envelope if compress is set, and returns it unchanged otherwise.
+func EncodeTx(bz []byte, compress bool) ([]byte, error) {
+	if !compress {
+		return bz, nil
+	}
+	maxLen := snappy.MaxEncodedLen(len(bz))
+	if maxLen < 0 || len(bz) > MaxDecodedTxSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTxTooLarge, len(bz))
+	}
+
+	envelope := make([]byte, 1+maxLen)
+	envelope[0] = VersionSnappy
+	compressed := snappy.Encode(envelope[1:], bz)
+	return envelope[:1+len(compressed)], nil
+}
+
+// DecodeTx unwraps the SSZ encoded consensus transaction from the versioned
+// compressed envelope if compressed is set, and returns bz unchanged
+// otherwise. The decoded size is checked against maxSize before
+// decompressing.
+func DecodeTx(bz []byte, compressed bool, maxSize int64) ([]byte, error) {
+	if !compressed {
+		return bz, nil
+	}
+	if len(bz) == 0 {
+		return nil, ErrInvalidEnvelope
+	}
+
+	switch bz[0] {
+	case VersionSnappy:
+		decodedLen, err := snappy.DecodedLen(bz[1:])
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidEnvelope, "%v", err)
+		}
+		if int64(decodedLen) > maxSize {
+			return nil, fmt.Errorf(
+				"%w: decodes to %d bytes, max %d", ErrTxTooLarge, decodedLen, maxSize,
+			)
+		}
+		decoded, err := snappy.Decode(nil, bz[1:])
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidEnvelope, "%v", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("%w: 0x%02x", ErrUnknownEnvelopeVersion, bz[0])
+	}
+}
+
+// DecodeTxs unwraps every consensus transaction of txs from the compressed
+// envelope if compressed is set, and returns txs unchanged otherwise. Each
+// transaction may decode to at most maxSize bytes.
+func DecodeTxs(txs [][]byte, compressed bool, maxSize int64) ([][]byte, error) {
+	if !compressed {
+		return txs, nil
+	}
+	decoded := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := DecodeTx(tx, compressed, maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding tx %d: %w", i, err)
+		}
+		decoded[i] = bz
+	}
+	return decoded, nil
+}
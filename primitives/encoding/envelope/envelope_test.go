@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package envelope_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/envelope"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	t.Parallel()
+	raw := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 1024)
+
+	wrapped, err := envelope.EncodeTx(raw, true)
+	require.NoError(t, err)
+	require.Equal(t, envelope.VersionSnappy, wrapped[0])
+	require.Less(t, len(wrapped), len(raw))
+
+	decoded, err := envelope.DecodeTx(wrapped, true, envelope.MaxDecodedTxSize)
+	require.NoError(t, err)
+	require.Equal(t, raw, decoded)
+}
+
+func TestEnvelopeDisabled(t *testing.T) {
+	t.Parallel()
+	raw := []byte{0x01, 0x02, 0x03}
+
+	wrapped, err := envelope.EncodeTx(raw, false)
+	require.NoError(t, err)
+	require.Equal(t, raw, wrapped)
+
+	txs, err := envelope.DecodeTxs([][]byte{raw}, false, envelope.MaxDecodedTxSize)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{raw}, txs)
+}
+
+func TestEnvelopeDecodeErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		tx          []byte
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			tx:          nil,
+			expectedErr: envelope.ErrInvalidEnvelope,
+		},
+		{
+			name:        "unknown version",
+			tx:          []byte{0x02, 0x00},
+			expectedErr: envelope.ErrUnknownEnvelopeVersion,
+		},
+		{
+			name:        "corrupted payload",
+			tx:          []byte{envelope.VersionSnappy, 0xff, 0xff, 0xff, 0xff, 0xff},
+			expectedErr: envelope.ErrInvalidEnvelope,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := envelope.DecodeTx(tt.tx, true, envelope.MaxDecodedTxSize)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			_, err = envelope.DecodeTxs([][]byte{tt.tx}, true, envelope.MaxDecodedTxSize)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestEnvelopeDecodeMaxSize(t *testing.T) {
+	t.Parallel()
+	// A highly compressible payload decoding to far more than its wire size.
+	const maxBlockBytes = 1 << 20
+	raw := make([]byte, maxBlockBytes+1)
+	wrapped, err := envelope.EncodeTx(raw, true)
+	require.NoError(t, err)
+	require.Less(t, len(wrapped), maxBlockBytes/10)
+
+	maxSize := envelope.MaxTxSize(maxBlockBytes)
+	_, err = envelope.DecodeTx(wrapped, true, maxSize)
+	require.ErrorIs(t, err, envelope.ErrTxTooLarge)
+	_, err = envelope.DecodeTxs([][]byte{wrapped}, true, maxSize)
+	require.ErrorIs(t, err, envelope.ErrTxTooLarge)
+
+	decoded, err := envelope.DecodeTx(wrapped, true, maxSize+1)
+	require.NoError(t, err)
+	require.Equal(t, raw, decoded)
+}
+
+func TestMaxTxSize(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, int64(1<<20), envelope.MaxTxSize(1<<20))
+	require.Equal(t, int64(envelope.MaxDecodedTxSize), envelope.MaxTxSize(-1))
+	require.Equal(t, int64(envelope.MaxDecodedTxSize), envelope.MaxTxSize(0))
+	require.Equal(t, int64(envelope.MaxDecodedTxSize), envelope.MaxTxSize(envelope.MaxDecodedTxSize+1))
+}
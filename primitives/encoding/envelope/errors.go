@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package envelope
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrInvalidEnvelope is an error for when a compressed consensus
+	// transaction cannot be decoded.
+	ErrInvalidEnvelope = errors.New("invalid consensus tx envelope")
+
+	// ErrUnknownEnvelopeVersion is an error for when a compressed consensus
+	// transaction uses an unknown envelope version.
+	ErrUnknownEnvelopeVersion = errors.New("unknown consensus tx envelope version")
+
+	// ErrTxTooLarge is an error for when a consensus transaction exceeds
+	// the maximum size once decoded.
+	ErrTxTooLarge = errors.New("consensus tx too large")
+)
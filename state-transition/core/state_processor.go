@@ -28,6 +28,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -90,23 +91,31 @@ func (sp *StateProcessor) Transition(
 		return nil, nil
 	}
 
-	// Process the next slot.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
-	if err != nil {
-		return nil, err
-	}
+	var validatorUpdates transition.ValidatorUpdates
+	err := traced(ctx, "StateProcessor.Transition", func(ctx ReadOnlyContext) error {
+		// Process the next slot.
+		err := traced(ctx, "StateProcessor.ProcessSlots", func(ReadOnlyContext) error {
+			var err error
+			validatorUpdates, err = sp.ProcessSlots(st, blk.GetSlot())
+			return err
+		})
+		if err != nil {
+			return err
+		}
 
-	// Prepare the state for the next block's fork version, logging only once during FinalizeBlock.
-	//
-	// TODO: allow the state transition context to directly indicate what stage of block processing
-	// we are in, i.e. ProcessProposal, FinalizeBlock, etc.
-	inFinalizeBlock := ctx.VerifyPayload() && !ctx.VerifyRandao()
-	if err = sp.ProcessFork(st, blk.GetTimestamp(), inFinalizeBlock); err != nil {
-		return nil, err
-	}
+		// Prepare the state for the next block's fork version, logging only once during FinalizeBlock.
+		//
+		// TODO: allow the state transition context to directly indicate what stage of block processing
+		// we are in, i.e. ProcessProposal, FinalizeBlock, etc.
+		inFinalizeBlock := ctx.VerifyPayload() && !ctx.VerifyRandao()
+		if err = sp.ProcessFork(st, blk.GetTimestamp(), inFinalizeBlock); err != nil {
+			return err
+		}
 
-	// Process the block.
-	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
+		// Process the block.
+		return sp.ProcessBlock(ctx, st, blk)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -208,23 +217,33 @@ func (sp *StateProcessor) ProcessBlock(
 	st *state.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
-	if err := sp.processBlockHeader(ctx, st, blk); err != nil {
+	if err := traced(ctx, "StateProcessor.processBlockHeader", func(ctx ReadOnlyContext) error {
+		return sp.processBlockHeader(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processExecutionPayload(ctx, st, blk); err != nil {
+	if err := traced(ctx, "StateProcessor.processExecutionPayload", func(ctx ReadOnlyContext) error {
+		return sp.processExecutionPayload(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processWithdrawals(st, blk); err != nil {
+	if err := traced(ctx, "StateProcessor.processWithdrawals", func(ReadOnlyContext) error {
+		return sp.processWithdrawals(st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processRandaoReveal(ctx, st, blk); err != nil {
+	if err := traced(ctx, "StateProcessor.processRandaoReveal", func(ctx ReadOnlyContext) error {
+		return sp.processRandaoReveal(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err := sp.processOperations(ctx, st, blk); err != nil {
+	if err := traced(ctx, "StateProcessor.processOperations", func(ctx ReadOnlyContext) error {
+		return sp.processOperations(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
@@ -236,7 +255,9 @@ func (sp *StateProcessor) ProcessBlock(
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
+	_, span := tracing.Start(ctx.ConsensusCtx(), "StateProcessor.HashTreeRoot")
 	stateRoot := st.HashTreeRoot()
+	span.End()
 	if blk.GetStateRoot() != stateRoot {
 		return errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/observability/tracing"
+)
+
+// tracedContext overrides the consensus context of a transition context so
+// that spans started by nested phases become children of the phase span.
+type tracedContext struct {
+	ReadOnlyContext
+	ctx context.Context
+}
+
+// ConsensusCtx returns the context carrying the phase span.
+func (c tracedContext) ConsensusCtx() context.Context {
+	return c.ctx
+}
+
+// traced runs a state transition phase within a span with the given name.
+func traced(
+	ctx ReadOnlyContext,
+	name string,
+	phase func(ReadOnlyContext) error,
+) error {
+	spanCtx, span := tracing.Start(ctx.ConsensusCtx(), name)
+	err := phase(tracedContext{ReadOnlyContext: ctx, ctx: spanCtx})
+	tracing.End(span, err)
+	return err
+}
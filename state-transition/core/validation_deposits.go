@@ -83,11 +83,12 @@ func ValidateNonGenesisDeposits(
 		return err
 	}
 
-	// Grab all previous deposits from genesis up to the current index + max deposits per block.
+	// Grab the deposits from the current index up to max deposits per block, along with
+	// the deposit root over all the deposits up to the last one returned.
 	localDeposits, localDepositRoot, err := depositStore.GetDepositsByIndex(
 		ctx,
-		constants.FirstDepositIndex,
-		depositIndex+maxDepositsPerBlock,
+		depositIndex,
+		maxDepositsPerBlock,
 	)
 	if err != nil {
 		return err
@@ -95,10 +96,11 @@ func ValidateNonGenesisDeposits(
 
 	// First verify that the number of block deposits matches the number of local deposits.
 	totalBlockDeposits := depositIndex + uint64(len(blkDeposits))
-	if uint64(len(localDeposits)) != totalBlockDeposits {
+	totalLocalDeposits := depositIndex + uint64(len(localDeposits))
+	if totalLocalDeposits != totalBlockDeposits {
 		return errors.Wrapf(ErrDepositsLengthMismatch,
 			"block deposit count: %d, expected deposit count: %d",
-			totalBlockDeposits, len(localDeposits),
+			totalBlockDeposits, totalLocalDeposits,
 		)
 	}
 
@@ -113,10 +115,10 @@ func ValidateNonGenesisDeposits(
 			)
 		}
 
-		if !localDeposits[blkDepositIndex].Equals(blkDeposit) {
+		if !localDeposits[i].Equals(blkDeposit) {
 			return errors.Wrapf(ErrDepositMismatch,
 				"deposit index: %d, expected deposit: %+v, actual deposit: %+v",
-				blkDepositIndex, *localDeposits[blkDepositIndex], *blkDeposit,
+				blkDepositIndex, *localDeposits[i], *blkDeposit,
 			)
 		}
 	}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// ErrReadOnly is returned when writing to a database opened read-only.
+var ErrReadOnly = errors.New("database is read-only")
+
+// OpenReadOnlyDB opens the application database without ever writing to it,
+// so that it can be inspected while being left untouched. Only the PebbleDB
+// backend is supported.
+func OpenReadOnlyDB(rootDir string) (dbm.DB, error) {
+	dbPath := filepath.Join(rootDir, "data", "application"+dbm.DBFileSuffix)
+	opts := &pebble.Options{ReadOnly: true}
+	opts.EnsureDefaults()
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s read-only", dbPath)
+	}
+	return readOnlyDB{db: db}, nil
+}
+
+// readOnlyDB is a PebbleDB database rejecting all writes.
+type readOnlyDB struct {
+	db *pebble.DB
+}
+
+var _ dbm.DB = readOnlyDB{}
+
+func (r readOnlyDB) Get(key []byte) ([]byte, error) {
+	value, closer, err := r.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	// The value is copied to a non-nil slice, since nil means not found.
+	return append([]byte{}, value...), nil
+}
+
+func (r readOnlyDB) Has(key []byte) (bool, error) {
+	value, err := r.Get(key)
+	return value != nil, err
+}
+
+func (readOnlyDB) Set([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+func (readOnlyDB) SetSync([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+func (readOnlyDB) Delete([]byte) error {
+	return ErrReadOnly
+}
+
+func (readOnlyDB) DeleteSync([]byte) error {
+	return ErrReadOnly
+}
+
+func (r readOnlyDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return r.newIterator(start, end, false)
+}
+
+func (r readOnlyDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return r.newIterator(start, end, true)
+}
+
+func (r readOnlyDB) newIterator(start, end []byte, reverse bool) (dbm.Iterator, error) {
+	it, err := r.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		it.Last()
+	} else {
+		it.First()
+	}
+	return &readOnlyIterator{source: it, start: start, end: end, reverse: reverse}, nil
+}
+
+func (r readOnlyDB) Close() error {
+	return r.db.Close()
+}
+
+func (readOnlyDB) NewBatch() dbm.Batch {
+	return readOnlyBatch{}
+}
+
+func (readOnlyDB) NewBatchWithSize(int) dbm.Batch {
+	return readOnlyBatch{}
+}
+
+func (readOnlyDB) Print() error {
+	return nil
+}
+
+func (readOnlyDB) Stats() map[string]string {
+	return nil
+}
+
+// readOnlyBatch is a batch which cannot be written.
+type readOnlyBatch struct{}
+
+func (readOnlyBatch) Set([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+func (readOnlyBatch) Delete([]byte) error {
+	return ErrReadOnly
+}
+
+func (readOnlyBatch) Write() error {
+	return ErrReadOnly
+}
+
+func (readOnlyBatch) WriteSync() error {
+	return ErrReadOnly
+}
+
+func (readOnlyBatch) Close() error {
+	return nil
+}
+
+func (readOnlyBatch) GetByteSize() (int, error) {
+	return 0, nil
+}
+
+// readOnlyIterator iterates over the keys of a domain of a readOnlyDB.
+type readOnlyIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	reverse    bool
+}
+
+func (it *readOnlyIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+func (it *readOnlyIterator) Valid() bool {
+	return it.source.Valid()
+}
+
+func (it *readOnlyIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.reverse {
+		it.source.Prev()
+	} else {
+		it.source.Next()
+	}
+}
+
+func (it *readOnlyIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	return bytes.Clone(it.source.Key())
+}
+
+func (it *readOnlyIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	return bytes.Clone(it.source.Value())
+}
+
+func (it *readOnlyIterator) Error() error {
+	return it.source.Error()
+}
+
+func (it *readOnlyIterator) Close() error {
+	return it.source.Close()
+}
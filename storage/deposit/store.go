@@ -29,6 +29,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 	depositstorev1 "github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -42,6 +43,11 @@ type Store interface {
 	GetDepositsByIndex(ctx context.Context, startIndex uint64, depRange uint64) (ctypes.Deposits, common.Root, error)
 	EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error
 	Prune(ctx context.Context, start, end uint64) error
+	Finalize(ctx context.Context, count uint64, blockHash common.ExecutionHash, blockHeight math.U64) error
+	RollbackFinalized(ctx context.Context, count uint64, blockHash common.ExecutionHash, blockHeight math.U64) error
+	Snapshot() *ctypes.DepositTreeSnapshot
+	InitFromSnapshot(ctx context.Context, snapshot *ctypes.DepositTreeSnapshot) error
+	Sync() error
 	Close() error
 }
 
@@ -112,6 +118,13 @@ func (gs *generalStore) Close() error {
 	return gs.storeV1.Close()
 }
 
+func (gs *generalStore) Sync() error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	return gs.storeV1.Sync()
+}
+
 func (gs *generalStore) Prune(ctx context.Context, start, end uint64) error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
@@ -123,3 +136,56 @@ func (gs *generalStore) Prune(ctx context.Context, start, end uint64) error {
 		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
 	}
 }
+
+func (gs *generalStore) Finalize(
+	ctx context.Context,
+	count uint64,
+	blockHash common.ExecutionHash,
+	blockHeight math.U64,
+) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.Finalize(ctx, count, blockHash, blockHeight)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) RollbackFinalized(
+	ctx context.Context,
+	count uint64,
+	blockHash common.ExecutionHash,
+	blockHeight math.U64,
+) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.RollbackFinalized(ctx, count, blockHash, blockHeight)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) Snapshot() *ctypes.DepositTreeSnapshot {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	return gs.storeV1.Snapshot()
+}
+
+func (gs *generalStore) InitFromSnapshot(ctx context.Context, snapshot *ctypes.DepositTreeSnapshot) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.InitFromSnapshot(ctx, snapshot)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tree
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrDepositCountOutOfRange is returned when a root is requested for a
+	// deposit count which is not covered by the tree, either because it falls
+	// within the finalized deposits or because the tree has fewer deposits.
+	ErrDepositCountOutOfRange = errors.New("deposit count out of tree range")
+
+	// ErrFinalizedDeposit is returned when trying to modify a deposit that
+	// has already been finalized.
+	ErrFinalizedDeposit = errors.New("deposit already finalized")
+
+	// ErrNonContiguousDeposit is returned when a deposit is added at an
+	// index which is not the next one in the tree.
+	ErrNonContiguousDeposit = errors.New("deposit index is not contiguous")
+
+	// ErrInvalidSnapshot is returned when a snapshot is malformed or its
+	// finalized branches do not hash to its deposit root.
+	ErrInvalidSnapshot = errors.New("invalid deposit tree snapshot")
+)
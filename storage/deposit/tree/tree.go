@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tree
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle/zero"
+)
+
+// Depth is the depth of the deposit tree. It matches both the depth of the
+// deposit contract tree and the SSZ limit of the deposits list, so that the
+// root of the tree equals the hash tree root of the deposits.
+const Depth = constants.DepositContractDepth
+
+// Tree is an incremental Merkle tree over the hash tree roots of the deposits,
+// following EIP-4881. The finalized part of the tree is collapsed into at most
+// Depth branches, while the leaves of the deposits that are not finalized yet
+// are kept in memory to compute the root at any count past the finalized one.
+//
+// Tree is not safe for concurrent use.
+type Tree struct {
+	// finalizedBranch holds, for every bit h set in finalizedCount, the root of
+	// the full subtree of 2^h leaves covering the corresponding finalized deposits.
+	finalizedBranch [Depth]common.Root
+	finalizedCount  uint64
+
+	// executionBlockHash and executionBlockHeight identify the execution block
+	// at which the deposits were last finalized.
+	executionBlockHash   common.ExecutionHash
+	executionBlockHeight math.U64
+
+	// leaves are the roots of the deposits following the finalized ones.
+	leaves []common.Root
+}
+
+// New creates a new empty deposit tree.
+func New() *Tree {
+	return &Tree{}
+}
+
+// NewFromSnapshot creates a deposit tree whose finalized part is initialized
+// from the given snapshot. The snapshot is verified against its deposit root.
+func NewFromSnapshot(snapshot *ctypes.DepositTreeSnapshot) (*Tree, error) {
+	count := snapshot.DepositCount.Unwrap()
+	if len(snapshot.Finalized) != bits.OnesCount64(count) {
+		return nil, errors.Wrapf(ErrInvalidSnapshot,
+			"expected %d finalized branches for %d deposits, got %d",
+			bits.OnesCount64(count), count, len(snapshot.Finalized),
+		)
+	}
+
+	// Finalized branches are ordered from left to right, i.e. from the
+	// largest subtree to the smallest one.
+	t := &Tree{
+		finalizedCount:       count,
+		executionBlockHash:   snapshot.ExecutionBlockHash,
+		executionBlockHeight: snapshot.ExecutionBlockHeight,
+	}
+	next := 0
+	for h := Depth; h > 0; h-- {
+		if count&(1<<(h-1)) != 0 {
+			t.finalizedBranch[h-1] = snapshot.Finalized[next]
+			next++
+		}
+	}
+
+	if root := t.Root(); root != snapshot.DepositRoot {
+		return nil, errors.Wrapf(ErrInvalidSnapshot,
+			"deposit root mismatch, expected %s, computed %s", snapshot.DepositRoot, root,
+		)
+	}
+	return t, nil
+}
+
+// Count returns the number of deposits in the tree.
+func (t *Tree) Count() uint64 {
+	return t.finalizedCount + uint64(len(t.leaves))
+}
+
+// FinalizedCount returns the number of finalized deposits in the tree.
+func (t *Tree) FinalizedCount() uint64 {
+	return t.finalizedCount
+}
+
+// Push appends the leaf of the deposit at the given index, which must be the
+// next index of the tree.
+func (t *Tree) Push(index uint64, leaf common.Root) error {
+	if index != t.Count() {
+		return errors.Wrapf(ErrNonContiguousDeposit,
+			"index %d, expected %d", index, t.Count(),
+		)
+	}
+	t.leaves = append(t.leaves, leaf)
+	return nil
+}
+
+// Replace replaces the leaf of an already pushed deposit which is not
+// finalized yet.
+func (t *Tree) Replace(index uint64, leaf common.Root) error {
+	switch {
+	case index < t.finalizedCount:
+		return errors.Wrapf(ErrFinalizedDeposit,
+			"index %d, finalized count %d", index, t.finalizedCount,
+		)
+	case index >= t.Count():
+		return errors.Wrapf(ErrNonContiguousDeposit,
+			"index %d, count %d", index, t.Count(),
+		)
+	}
+	t.leaves[index-t.finalizedCount] = leaf
+	return nil
+}
+
+// Root returns the root of the tree over all its deposits.
+func (t *Tree) Root() common.Root {
+	root, _ := t.RootAt(t.Count())
+	return root
+}
+
+// RootAt returns the root of the tree over the first count deposits. The count
+// must be within the finalized count and the number of deposits in the tree.
+func (t *Tree) RootAt(count uint64) (common.Root, error) {
+	if count < t.finalizedCount || count > t.Count() {
+		return common.Root{}, errors.Wrapf(ErrDepositCountOutOfRange,
+			"count %d, finalized count %d, tree count %d",
+			count, t.finalizedCount, t.Count(),
+		)
+	}
+
+	branch := t.finalizedBranch
+	for i, leaf := range t.leaves[:count-t.finalizedCount] {
+		insert(&branch, t.finalizedCount+uint64(i), leaf)
+	}
+	return root(&branch, count), nil
+}
+
+// Finalize marks the first count deposits as finalized at the given execution
+// block, dropping their leaves from memory.
+func (t *Tree) Finalize(
+	count uint64, blockHash common.ExecutionHash, blockHeight math.U64,
+) error {
+	if count < t.finalizedCount || count > t.Count() {
+		return errors.Wrapf(ErrDepositCountOutOfRange,
+			"count %d, finalized count %d, tree count %d",
+			count, t.finalizedCount, t.Count(),
+		)
+	}
+
+	finalized := count - t.finalizedCount
+	for i, leaf := range t.leaves[:finalized] {
+		insert(&t.finalizedBranch, t.finalizedCount+uint64(i), leaf)
+	}
+	t.leaves = append([]common.Root(nil), t.leaves[finalized:]...)
+	t.finalizedCount = count
+	t.executionBlockHash = blockHash
+	t.executionBlockHeight = blockHeight
+	return nil
+}
+
+// Snapshot returns the EIP-4881 snapshot of the finalized part of the tree.
+func (t *Tree) Snapshot() *ctypes.DepositTreeSnapshot {
+	finalized := make([]common.Root, 0, bits.OnesCount64(t.finalizedCount))
+	for h := Depth; h > 0; h-- {
+		if t.finalizedCount&(1<<(h-1)) != 0 {
+			finalized = append(finalized, t.finalizedBranch[h-1])
+		}
+	}
+
+	return &ctypes.DepositTreeSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          root(&t.finalizedBranch, t.finalizedCount),
+		DepositCount:         math.U64(t.finalizedCount),
+		ExecutionBlockHash:   t.executionBlockHash,
+		ExecutionBlockHeight: t.executionBlockHeight,
+	}
+}
+
+// insert adds the leaf at the given index to the branch, following the
+// incremental Merkle tree algorithm of the deposit contract.
+func insert(branch *[Depth]common.Root, index uint64, leaf common.Root) {
+	node := leaf
+	size := index + 1
+	for h := range Depth {
+		if size&1 == 1 {
+			branch[h] = node
+			return
+		}
+		node = hashPair(branch[h], node)
+		size >>= 1
+	}
+}
+
+// root computes the root of the tree over count leaves from the branch, mixing
+// in the count as done for SSZ lists.
+func root(branch *[Depth]common.Root, count uint64) common.Root {
+	var node common.Root
+	size := count
+	for h := range Depth {
+		if size&1 == 1 {
+			node = hashPair(branch[h], node)
+		} else {
+			node = hashPair(node, zero.Hashes[h])
+		}
+		size >>= 1
+	}
+
+	var length common.Root
+	binary.LittleEndian.PutUint64(length[:], count)
+	return hashPair(node, length)
+}
+
+func hashPair(a, b common.Root) common.Root {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Hash(buf[:])
+}
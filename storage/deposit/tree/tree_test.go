@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tree_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/deposit/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeposits(n int) ctypes.Deposits {
+	deposits := make(ctypes.Deposits, 0, n)
+	for i := range n {
+		b := uint8(i % 255)
+		deposits = append(deposits, &ctypes.Deposit{
+			Pubkey:      [48]byte{b},
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{b}),
+			Amount:      math.Gwei(32_000_000_000 + uint64(i)),
+			Signature:   crypto.BLSSignature{b},
+			Index:       uint64(i),
+		})
+	}
+	return deposits
+}
+
+func TestTreeRootMatchesDepositsHashTreeRoot(t *testing.T) {
+	t.Parallel()
+	deposits := testDeposits(70)
+
+	tr := tree.New()
+	require.Equal(t, ctypes.Deposits{}.HashTreeRoot(), tr.Root())
+	for i, d := range deposits {
+		require.NoError(t, tr.Push(uint64(i), d.HashTreeRoot()))
+		require.Equal(t, deposits[:i+1].HashTreeRoot(), tr.Root())
+	}
+
+	for _, count := range []uint64{0, 1, 31, 32, 33, 64, 70} {
+		root, err := tr.RootAt(count)
+		require.NoError(t, err)
+		require.Equal(t, deposits[:count].HashTreeRoot(), root)
+	}
+	_, err := tr.RootAt(71)
+	require.ErrorIs(t, err, tree.ErrDepositCountOutOfRange)
+	require.ErrorIs(t, tr.Push(72, common.Root{}), tree.ErrNonContiguousDeposit)
+}
+
+func TestTreeFinalizeAndSnapshot(t *testing.T) {
+	t.Parallel()
+	deposits := testDeposits(50)
+
+	tr := tree.New()
+	for i, d := range deposits {
+		require.NoError(t, tr.Push(uint64(i), d.HashTreeRoot()))
+	}
+	blockHash := common.ExecutionHash{0xaa}
+	require.NoError(t, tr.Finalize(37, blockHash, 100))
+	require.Equal(t, uint64(37), tr.FinalizedCount())
+	require.Equal(t, uint64(50), tr.Count())
+
+	// Roots past the finalized count are still available, roots before it are not.
+	root, err := tr.RootAt(45)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:45].HashTreeRoot(), root)
+	_, err = tr.RootAt(36)
+	require.ErrorIs(t, err, tree.ErrDepositCountOutOfRange)
+
+	// Finalized deposits cannot be replaced, pending ones can.
+	require.ErrorIs(t, tr.Replace(10, common.Root{}), tree.ErrFinalizedDeposit)
+	require.NoError(t, tr.Replace(40, common.Root{0x01}))
+	require.NoError(t, tr.Replace(40, deposits[40].HashTreeRoot()))
+
+	snapshot := tr.Snapshot()
+	require.Len(t, snapshot.Finalized, 3) // 37 = 0b100101
+	require.Equal(t, deposits[:37].HashTreeRoot(), snapshot.DepositRoot)
+	require.Equal(t, math.U64(37), snapshot.DepositCount)
+	require.Equal(t, blockHash, snapshot.ExecutionBlockHash)
+	require.Equal(t, math.U64(100), snapshot.ExecutionBlockHeight)
+
+	// A tree restored from the SSZ encoded snapshot can be extended with the
+	// remaining deposits only.
+	bz, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := ctypes.NewEmptyDepositTreeSnapshot()
+	require.NoError(t, ssz.Unmarshal(bz, decoded))
+	require.Equal(t, snapshot.HashTreeRoot(), decoded.HashTreeRoot())
+
+	restored, err := tree.NewFromSnapshot(decoded)
+	require.NoError(t, err)
+	for i := 37; i < len(deposits); i++ {
+		require.NoError(t, restored.Push(uint64(i), deposits[i].HashTreeRoot()))
+	}
+	require.Equal(t, tr.Root(), restored.Root())
+	require.Equal(t, deposits.HashTreeRoot(), restored.Root())
+}
+
+func TestNewFromInvalidSnapshot(t *testing.T) {
+	t.Parallel()
+	deposits := testDeposits(5)
+
+	tr := tree.New()
+	for i, d := range deposits {
+		require.NoError(t, tr.Push(uint64(i), d.HashTreeRoot()))
+	}
+	require.NoError(t, tr.Finalize(5, common.ExecutionHash{}, 0))
+
+	snapshot := tr.Snapshot()
+	snapshot.Finalized = snapshot.Finalized[:1]
+	_, err := tree.NewFromSnapshot(snapshot)
+	require.ErrorIs(t, err, tree.ErrInvalidSnapshot)
+
+	snapshot = tr.Snapshot()
+	snapshot.DepositRoot = common.Root{0x01}
+	_, err = tree.NewFromSnapshot(snapshot)
+	require.ErrorIs(t, err, tree.ErrInvalidSnapshot)
+}
@@ -29,19 +29,40 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage"
 	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+	"github.com/berachain/beacon-kit/storage/deposit/tree"
 	"github.com/berachain/beacon-kit/storage/encoding"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix  = "deposit"
+	KeySnapshotPrefix = "snapshot"
+)
+
+// ErrSnapshotOnNonEmptyStore is returned when trying to initialize the deposit
+// tree from a snapshot on a store which already tracks deposits.
+var ErrSnapshotOnNonEmptyStore = errors.New("deposit tree snapshot on non empty store")
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
+	// db is the database backing the store.
+	db    dbm.DB
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
 
+	// snapshot is the EIP-4881 snapshot of the finalized deposits.
+	snapshot sdkcollections.Item[*ctypes.DepositTreeSnapshot]
+
+	// tree is the incremental Merkle tree over the stored deposits. It spans
+	// the contiguous deposits following the ones finalized in snapshot.
+	tree *tree.Tree
+	// mu guards tree, along with the stored deposits it is built from, as
+	// deposits are enqueued by the deposit fetchers while blocks read them.
+	mu sync.RWMutex
+
 	// closeFunc is a closure that closes the underlying database
 	// used by store to ensure that all writes are flushed to disk.
 	// We guarantee that closeFunc is called at maximum only once.
@@ -66,6 +87,7 @@ func NewStore(
 
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
 	res := &KVStore{
+		db: baseDB,
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte(KeyDepositPrefix)),
@@ -75,15 +97,75 @@ func NewStore(
 				NewEmptyF: ctypes.NewEmptyDeposit,
 			},
 		),
+		snapshot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySnapshotPrefix)),
+			KeySnapshotPrefix,
+			encoding.SSZValueCodec[*ctypes.DepositTreeSnapshot]{
+				NewEmptyF: ctypes.NewEmptyDepositTreeSnapshot,
+			},
+		),
 		closeFunc: closeFunc,
 		logger:    logger,
 	}
 	if _, err := schemaBuilder.Build(); err != nil {
 		panic(errors.Wrap(err, "failed building KVStore schema"))
 	}
+	if err := res.loadTree(context.Background()); err != nil {
+		panic(errors.Wrap(err, "failed loading deposit tree"))
+	}
 	return res
 }
 
+// loadTree rebuilds the deposit tree from the persisted snapshot, if any,
+// and the stored deposits following it.
+func (kv *KVStore) loadTree(ctx context.Context) error {
+	snapshot, err := kv.snapshot.Get(ctx)
+	switch {
+	case err == nil:
+		if kv.tree, err = tree.NewFromSnapshot(snapshot); err != nil {
+			return err
+		}
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		kv.tree = tree.New()
+	default:
+		return errors.Wrap(err, "failed to get deposit tree snapshot")
+	}
+	return kv.extendTree(ctx)
+}
+
+// extendTree pushes to the tree the stored deposits contiguous to its last one.
+// It must be called with mu held.
+func (kv *KVStore) extendTree(ctx context.Context) error {
+	for idx := kv.tree.Count(); ; idx++ {
+		deposit, err := kv.store.Get(ctx, idx)
+		switch {
+		case err == nil:
+			if err = kv.tree.Push(idx, deposit.HashTreeRoot()); err != nil {
+				return err
+			}
+		case errors.Is(err, sdkcollections.ErrNotFound):
+			return nil
+		default:
+			return errors.Wrapf(err, "failed to get deposit %d", idx)
+		}
+	}
+}
+
+// Sync commits the writes to the store to stable storage, including those
+// which are not synced as they happen, e.g. the pruning of deposits.
+func (kv *KVStore) Sync() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// Committing an empty batch synchronously syncs the preceding writes.
+	batch := kv.db.NewBatch()
+	if err := batch.WriteSync(); err != nil {
+		return errors.Join(errors.Wrap(err, "failed to sync deposit store"), batch.Close())
+	}
+	return batch.Close()
+}
+
 // Close closes the store by calling the closeFunc. It ensures that the
 // closeFunc is called at most once.
 func (kv *KVStore) Close() error {
@@ -95,13 +177,16 @@ func (kv *KVStore) Close() error {
 // GetDepositsByIndex returns the first N deposits starting from the given
 // index. If N is greater than the number of deposits, it returns up to the
 // last deposit.
-// Note: we return the hash root of the selected deposits to simplify block
-// building pre migration to deposit store V2.
+// The returned root is the deposit root over all the deposits up to the last
+// returned one, i.e. the one to be included in a block processing them.
 func (kv *KVStore) GetDepositsByIndex(
 	ctx context.Context,
 	startIndex uint64,
 	depRange uint64,
 ) (ctypes.Deposits, common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
 	var (
 		deposits = make(ctypes.Deposits, 0, depRange)
 		endIdx   = startIndex + depRange
@@ -123,17 +208,73 @@ func (kv *KVStore) GetDepositsByIndex(
 	}
 
 	kv.logger.Debug("GetDepositsByIndex", "start", startIndex, "end", endIdx)
-	return deposits, deposits.HashTreeRoot(), nil
+	root, err := kv.depositRoot(ctx, startIndex, deposits)
+	return deposits, root, err
+}
+
+// depositRoot returns the root over the deposits preceding startIndex followed
+// by the given ones. The tree is used when it covers the count, otherwise the
+// root is recomputed from the stored deposits. It must be called with mu held.
+func (kv *KVStore) depositRoot(
+	ctx context.Context,
+	startIndex uint64,
+	deposits ctypes.Deposits,
+) (common.Root, error) {
+	count := startIndex + uint64(len(deposits))
+	root, err := kv.tree.RootAt(count)
+	if err == nil {
+		return root, nil
+	}
+	if !errors.Is(err, tree.ErrDepositCountOutOfRange) {
+		return common.Root{}, err
+	}
+	if count > kv.tree.Count() {
+		// Deposits are contiguous in the tree, so some before startIndex are missing.
+		return common.Root{}, errors.Wrapf(storage.ErrDepositsUnavailable,
+			"deposits [%d, %d) not enqueued yet", kv.tree.Count(), startIndex,
+		)
+	}
+
+	// The count is finalized already, which happens when processing historical
+	// blocks. Fall back to hashing the deposits, if all of them are stored.
+	all := make(ctypes.Deposits, 0, count)
+	for i := range startIndex {
+		deposit, errGet := kv.store.Get(ctx, i)
+		switch {
+		case errGet == nil:
+			all = append(all, deposit)
+		case errors.Is(errGet, sdkcollections.ErrNotFound):
+			return common.Root{}, errors.Wrapf(storage.ErrDepositsUnavailable,
+				"deposit %d preceding the deposit tree snapshot", i,
+			)
+		default:
+			return common.Root{}, errors.Wrapf(errGet, "failed to get deposit %d", i)
+		}
+	}
+	return append(all, deposits...).HashTreeRoot(), nil
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
 func (kv *KVStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
 	for _, deposit := range deposits {
 		idx := deposit.GetIndex().Unwrap()
+		if idx < kv.tree.Count() {
+			// The deposit is already in the tree. This happens when deposits of
+			// an execution block are fetched more than once.
+			if err := kv.replaceInTree(ctx, deposit); err != nil {
+				return err
+			}
+		}
 		if err := kv.store.Set(ctx, idx, deposit); err != nil {
 			return errors.Wrapf(err, "failed to enqueue deposit %d", idx)
 		}
 	}
+	if err := kv.extendTree(ctx); err != nil {
+		return errors.Wrap(err, "failed to extend deposit tree")
+	}
 
 	if len(deposits) > 0 {
 		kv.logger.Debug(
@@ -144,6 +285,145 @@ func (kv *KVStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.Depos
 	return nil
 }
 
+// replaceInTree updates the tree leaf of an already enqueued deposit. Finalized
+// deposits are never modified. It must be called with mu held.
+func (kv *KVStore) replaceInTree(ctx context.Context, deposit *ctypes.Deposit) error {
+	idx := deposit.GetIndex().Unwrap()
+	leaf := deposit.HashTreeRoot()
+	if idx >= kv.tree.FinalizedCount() {
+		return kv.tree.Replace(idx, leaf)
+	}
+
+	stored, err := kv.store.Get(ctx, idx)
+	switch {
+	case err == nil:
+		if stored.HashTreeRoot() != leaf {
+			return errors.Wrapf(tree.ErrFinalizedDeposit, "deposit %d differs from the stored one", idx)
+		}
+		return nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		// The store was initialized from a snapshot, hence finalized deposits
+		// cannot be verified against their leaf.
+		return nil
+	default:
+		return errors.Wrapf(err, "failed to get deposit %d", idx)
+	}
+}
+
+// Finalize marks the first count deposits as finalized at the given execution
+// block and persists the resulting deposit tree snapshot.
+func (kv *KVStore) Finalize(
+	ctx context.Context,
+	count uint64,
+	blockHash common.ExecutionHash,
+	blockHeight math.U64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if count <= kv.tree.FinalizedCount() {
+		// Nothing to do, this happens when blocks are replayed.
+		return nil
+	}
+	if err := kv.tree.Finalize(count, blockHash, blockHeight); err != nil {
+		return err
+	}
+	if err := kv.snapshot.Set(ctx, kv.tree.Snapshot()); err != nil {
+		return errors.Wrap(err, "failed to store deposit tree snapshot")
+	}
+
+	kv.logger.Debug("Finalized deposits", "count", count, "block_height", blockHeight)
+	return nil
+}
+
+// RollbackFinalized marks only the first count deposits as finalized, at the
+// given execution block, e.g. when the beacon state is rolled back. The deposit
+// tree is rebuilt from the stored deposits, hence all the deposits finalized so
+// far must be stored. It is a no-op if no more than count deposits are finalized.
+func (kv *KVStore) RollbackFinalized(
+	ctx context.Context,
+	count uint64,
+	blockHash common.ExecutionHash,
+	blockHeight math.U64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	finalizedCount := kv.tree.FinalizedCount()
+	if count >= finalizedCount {
+		return nil
+	}
+
+	t := tree.New()
+	for idx := range finalizedCount {
+		deposit, err := kv.store.Get(ctx, idx)
+		switch {
+		case err == nil:
+			if err = t.Push(idx, deposit.HashTreeRoot()); err != nil {
+				return err
+			}
+		case errors.Is(err, sdkcollections.ErrNotFound):
+			return errors.Wrapf(storage.ErrDepositsUnavailable, "finalized deposit %d", idx)
+		default:
+			return errors.Wrapf(err, "failed to get deposit %d", idx)
+		}
+	}
+	if root := t.Root(); root != kv.tree.Snapshot().DepositRoot {
+		return errors.Wrapf(tree.ErrFinalizedDeposit,
+			"stored deposits root %s differs from the finalized one", root,
+		)
+	}
+	if err := t.Finalize(count, blockHash, blockHeight); err != nil {
+		return err
+	}
+
+	if count == 0 {
+		if err := kv.snapshot.Remove(ctx); err != nil {
+			return errors.Wrap(err, "failed to remove deposit tree snapshot")
+		}
+	} else if err := kv.snapshot.Set(ctx, t.Snapshot()); err != nil {
+		return errors.Wrap(err, "failed to store deposit tree snapshot")
+	}
+	kv.tree = t
+	if err := kv.extendTree(ctx); err != nil {
+		return errors.Wrap(err, "failed to extend deposit tree")
+	}
+
+	kv.logger.Debug("Rolled back finalized deposits", "count", count, "block_height", blockHeight)
+	return nil
+}
+
+// Snapshot returns the EIP-4881 snapshot of the finalized deposits.
+func (kv *KVStore) Snapshot() *ctypes.DepositTreeSnapshot {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.Snapshot()
+}
+
+// InitFromSnapshot initializes the deposit tree from the given snapshot, so
+// that deposits preceding it do not need to be stored. It is only allowed on
+// a store with no deposits.
+func (kv *KVStore) InitFromSnapshot(
+	ctx context.Context,
+	snapshot *ctypes.DepositTreeSnapshot,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if kv.tree.Count() != 0 {
+		return errors.Wrapf(ErrSnapshotOnNonEmptyStore, "store has %d deposits", kv.tree.Count())
+	}
+	t, err := tree.NewFromSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+	if err = kv.snapshot.Set(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "failed to store deposit tree snapshot")
+	}
+	kv.tree = t
+	return kv.extendTree(ctx)
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 	if start > end {
@@ -151,6 +431,9 @@ func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 			storage.ErrInvalidRange, "DepositKVStore Prune start: %d, end: %d", start, end)
 	}
 
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
 	for i := range end {
 		// This only errors if the key passed in cannot be encoded.
 		if err := kv.store.Remove(ctx, start+i); err != nil {
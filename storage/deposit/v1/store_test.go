@@ -22,6 +22,7 @@ package deposit_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -29,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage"
 	"github.com/berachain/beacon-kit/storage/db"
 	"github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
@@ -78,3 +80,195 @@ func BenchmarkDepositsInsertion(b *testing.B) {
 		}
 	}
 }
+
+func TestDepositSnapshot(t *testing.T) {
+	t.Parallel()
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	nopLog := log.NewNopLogger()
+	ctx := context.Background()
+
+	deposits := make(types.Deposits, 0, 20)
+	for i := range 20 {
+		b := uint8(i)
+		deposits = append(deposits, &types.Deposit{
+			Pubkey:      [48]byte{b},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{b}),
+			Amount:      10_000,
+			Signature:   crypto.BLSSignature{b},
+			Index:       uint64(i),
+		})
+	}
+
+	store := deposit.NewStore(baseDB, nopLog)
+	require.NoError(t, store.EnqueueDeposits(ctx, deposits[:15]))
+
+	// Roots are computed over all deposits preceding the returned ones.
+	got, root, err := store.GetDepositsByIndex(ctx, 5, 4)
+	require.NoError(t, err)
+	require.Equal(t, types.Deposits(deposits[5:9]), got)
+	require.Equal(t, deposits[:9].HashTreeRoot(), root)
+
+	// Finalized deposits are persisted in the snapshot and survive a restart.
+	require.NoError(t, store.Finalize(ctx, 10, common.ExecutionHash{0x01}, 7))
+	store = deposit.NewStore(baseDB, nopLog)
+	snapshot := store.Snapshot()
+	require.Equal(t, deposits[:10].HashTreeRoot(), snapshot.DepositRoot)
+	require.Equal(t, uint64(10), snapshot.DepositCount.Unwrap())
+
+	_, root, err = store.GetDepositsByIndex(ctx, 10, 16)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:15].HashTreeRoot(), root)
+
+	// Historical roots are still available from the stored deposits.
+	_, root, err = store.GetDepositsByIndex(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:5].HashTreeRoot(), root)
+
+	// A new store initialized from the snapshot only needs the following deposits.
+	otherDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	other := deposit.NewStore(otherDB, nopLog)
+	require.NoError(t, other.InitFromSnapshot(ctx, snapshot))
+	require.NoError(t, other.EnqueueDeposits(ctx, deposits[10:]))
+
+	got, root, err = other.GetDepositsByIndex(ctx, 10, 16)
+	require.NoError(t, err)
+	require.Len(t, got, 10)
+	require.Equal(t, deposits.HashTreeRoot(), root)
+
+	_, _, err = other.GetDepositsByIndex(ctx, 2, 3)
+	require.ErrorIs(t, err, storage.ErrDepositsUnavailable)
+	require.ErrorIs(t, other.InitFromSnapshot(ctx, snapshot), deposit.ErrSnapshotOnNonEmptyStore)
+}
+
+func TestDepositRollbackFinalized(t *testing.T) {
+	t.Parallel()
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	nopLog := log.NewNopLogger()
+	ctx := context.Background()
+
+	deposits := make(types.Deposits, 0, 12)
+	for i := range 12 {
+		b := uint8(i)
+		deposits = append(deposits, &types.Deposit{
+			Pubkey:      [48]byte{b},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{b}),
+			Amount:      10_000,
+			Signature:   crypto.BLSSignature{b},
+			Index:       uint64(i),
+		})
+	}
+
+	store := deposit.NewStore(baseDB, nopLog)
+	require.NoError(t, store.EnqueueDeposits(ctx, deposits))
+	require.NoError(t, store.Finalize(ctx, 10, common.ExecutionHash{0x02}, 9))
+
+	// Rolling back to a later count is a no-op.
+	require.NoError(t, store.RollbackFinalized(ctx, 11, common.ExecutionHash{0x03}, 10))
+	require.Equal(t, uint64(10), store.Snapshot().DepositCount.Unwrap())
+
+	// The snapshot is rolled back and persisted, and the deposits following it
+	// are still served.
+	require.NoError(t, store.RollbackFinalized(ctx, 6, common.ExecutionHash{0x01}, 5))
+	store = deposit.NewStore(baseDB, nopLog)
+	snapshot := store.Snapshot()
+	require.Equal(t, deposits[:6].HashTreeRoot(), snapshot.DepositRoot)
+	require.Equal(t, uint64(6), snapshot.DepositCount.Unwrap())
+	require.Equal(t, common.ExecutionHash{0x01}, snapshot.ExecutionBlockHash)
+
+	_, root, err := store.GetDepositsByIndex(ctx, 6, 6)
+	require.NoError(t, err)
+	require.Equal(t, deposits.HashTreeRoot(), root)
+	require.NoError(t, store.Finalize(ctx, 8, common.ExecutionHash{0x02}, 7))
+
+	// Finalized deposits can only be rolled back while stored.
+	require.NoError(t, store.Prune(ctx, 0, 1))
+	require.ErrorIs(t, store.RollbackFinalized(ctx, 4, common.ExecutionHash{}, 3), storage.ErrDepositsUnavailable)
+	require.Equal(t, uint64(8), store.Snapshot().DepositCount.Unwrap())
+}
+
+func TestDepositConcurrentAccess(t *testing.T) {
+	t.Parallel()
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	const count = 64
+	deposits := make(types.Deposits, 0, count)
+	for i := range count {
+		b := uint8(i)
+		deposits = append(deposits, &types.Deposit{
+			Pubkey:      [48]byte{b},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{b}),
+			Amount:      10_000,
+			Signature:   crypto.BLSSignature{b},
+			Index:       uint64(i),
+		})
+	}
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+
+	// Deposits are enqueued, as by the deposit fetchers, while blocks read and
+	// finalize them.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range deposits {
+			// Enqueue some deposits twice, as when refetching blocks.
+			start := max(0, i-1)
+			if err := store.EnqueueDeposits(ctx, deposits[start:i+1]); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range count {
+				got, root, errGet := store.GetDepositsByIndex(ctx, 0, count)
+				if errGet != nil {
+					t.Error(errGet)
+					return
+				}
+				if root != deposits[:len(got)].HashTreeRoot() {
+					t.Errorf("inconsistent root over %d deposits", len(got))
+					return
+				}
+				if errFin := store.Finalize(
+					ctx, uint64(len(got)/2), common.ExecutionHash{}, 0,
+				); errFin != nil {
+					t.Error(errFin)
+					return
+				}
+				_ = store.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, root, err := store.GetDepositsByIndex(ctx, 0, count)
+	require.NoError(t, err)
+	require.Equal(t, deposits.HashTreeRoot(), root)
+}
+
+func TestDepositSync(t *testing.T) {
+	t.Parallel()
+	baseDB, err := db.OpenDB(t.TempDir(), dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+	require.NoError(t, store.EnqueueDeposits(ctx, []*types.Deposit{{
+		Pubkey:      [48]byte{0x01},
+		Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+		Amount:      10_000,
+		Signature:   crypto.BLSSignature{0x01},
+	}}))
+	require.NoError(t, store.Prune(ctx, 0, 1))
+	require.NoError(t, store.Sync())
+	require.NoError(t, store.Close())
+}
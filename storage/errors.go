@@ -23,3 +23,7 @@ package storage
 import "github.com/berachain/beacon-kit/errors"
 
 var ErrInvalidRange = errors.New("range start greater than end")
+
+// ErrDepositsUnavailable is returned when deposits required to compute a
+// deposit root are not available in the deposit store.
+var ErrDepositsUnavailable = errors.New("deposits unavailable in deposit store")
@@ -101,6 +101,36 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
+// syncDir commits the files of the given directory, along with the directory
+// itself, to stable storage. A missing directory has nothing to commit.
+func (db *DB) syncDir(dir string) error {
+	entries, err := afero.ReadDir(db.fs, dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err = db.syncFile(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return db.syncFile(dir)
+}
+
+// syncFile commits the given file to stable storage.
+func (db *DB) syncFile(path string) error {
+	file, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	return errors.Join(file.Sync(), file.Close())
+}
+
 // pathForKey returns the path for a key.
 // TODO: for efficient storage we should expand this path.
 func (db *DB) pathForKey(key []byte) string {
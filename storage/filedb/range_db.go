@@ -58,6 +58,12 @@ type RangeDB struct {
 	// monotonicity. The goal is to make sure we do not overwrite
 	// indexes which have been or will be deleted eventually via pruning.
 	lowerBoundIndex uint64
+
+	// unsynced determines if values have been written since the last sync,
+	// at the indexes in [unsyncedFrom, unsyncedTo].
+	unsynced     bool
+	unsyncedFrom uint64
+	unsyncedTo   uint64
 }
 
 // NewRangeDB creates a new RangeDB.
@@ -98,7 +104,17 @@ func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
 	defer db.rwMu.Unlock()
 
 	index = max(index, db.lowerBoundIndex) // enforce invariant
-	return db.coreDB.Set(prefix(index, key), value)
+	if err := db.coreDB.Set(prefix(index, key), value); err != nil {
+		return err
+	}
+
+	if !db.unsynced {
+		db.unsynced = true
+		db.unsyncedFrom, db.unsyncedTo = index, index
+	}
+	db.unsyncedFrom = min(db.unsyncedFrom, index)
+	db.unsyncedTo = max(db.unsyncedTo, index)
+	return nil
 }
 
 // Delete removes the value associated with the given index and key from the
@@ -153,6 +169,34 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return err
 }
 
+// Truncate removes all values in the given range [start, end) from the db
+// without moving the pruning lower bound. It is used to discard the entries
+// ahead of a state that has been rolled back.
+func (db *RangeDB) Truncate(start, end uint64) error {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	return db.deleteRange(max(start, db.lowerBoundIndex), end)
+}
+
+// Sync commits the values written since the last sync to stable storage.
+func (db *RangeDB) Sync() error {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	if !db.unsynced {
+		return nil
+	}
+
+	// Values below the lower bound have been pruned.
+	for i := max(db.unsyncedFrom, db.lowerBoundIndex); i <= db.unsyncedTo; i++ {
+		path := fmt.Sprintf(pathFormat, i)
+		if err := db.coreDB.syncDir(path); err != nil {
+			return fmt.Errorf("RangeDB Sync failed index %d: %w", i, err)
+		}
+	}
+	db.unsynced = false
+	return nil
+}
+
 // GetByIndex takes the database index and returns all associated entries,
 // expecting database keys to follow the prefix() format. If index does not
 // exist in the DB for any reason (pruned, invalid index), an empty list is
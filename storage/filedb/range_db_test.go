@@ -273,6 +273,79 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_Truncate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		setupFunc     func(rdb *file.RangeDB) error
+		start         uint64
+		end           uint64
+		expectedError bool
+		testFunc      func(t *testing.T, rdb *file.RangeDB)
+	}{
+		{
+			name: "TruncateTail",
+			setupFunc: func(rdb *file.RangeDB) error {
+				return populateTestDB(rdb, 0, 50)
+			},
+			start:         30,
+			end:           51,
+			expectedError: false,
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				requireExist(t, rdb, 0, 29)
+				requireNotExist(t, rdb, 30, 50)
+			},
+		},
+		{
+			name: "TruncateDoesNotMoveLowerBound",
+			setupFunc: func(rdb *file.RangeDB) error {
+				return populateTestDB(rdb, 0, 10)
+			},
+			start:         5,
+			end:           11,
+			expectedError: false,
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				require.NoError(t, populateTestDB(rdb, 5, 10))
+				requireExist(t, rdb, 0, 10)
+			},
+		},
+		{
+			name: "TruncateInvalidRange",
+			setupFunc: func(rdb *file.RangeDB) error {
+				return populateTestDB(rdb, 0, 50)
+			},
+			start:         7,
+			end:           2,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-4"))
+
+			if tt.setupFunc != nil {
+				require.NoError(t, tt.setupFunc(rdb))
+			}
+			err := rdb.Truncate(tt.start, tt.end)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf(
+					"Truncate() error = %v, expectedError %v",
+					err,
+					tt.expectedError,
+				)
+			}
+
+			if tt.testFunc != nil {
+				tt.testFunc(t, rdb)
+			}
+		})
+	}
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -354,6 +427,25 @@ func TestRangeDB_Invariants(t *testing.T) {
 	}
 }
 
+func TestRangeDB_Sync(t *testing.T) {
+	t.Parallel()
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	// Nothing has been written yet.
+	require.NoError(t, rdb.Sync())
+
+	require.NoError(t, populateTestDB(rdb, 3, 10))
+	// The pruned indexes are skipped.
+	require.NoError(t, rdb.Prune(0, 5))
+	require.NoError(t, rdb.Sync())
+	requireNotExist(t, rdb, 3, 4)
+	requireExist(t, rdb, 5, 10)
+
+	require.NoError(t, rdb.Set(11, []byte("key"), []byte("value")))
+	require.NoError(t, rdb.Sync())
+	requireExist(t, rdb, 5, 11)
+}
+
 // =============================== HELPERS ==================================
 
 // newTestFDB returns a new file DB instance with an in-memory filesystem.
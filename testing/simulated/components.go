@@ -46,9 +46,12 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
 		components.ProvideEngineClient,
+		components.ProvideELSyncMonitor,
 		components.ProvideExecutionEngine,
+		components.ProvideFeeRecipients,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvidePayloadIDCache,
 		components.ProvideReportingService,
 		components.ProvideServiceRegistry,
 		components.ProvideSidecarFactory,
@@ -56,8 +59,13 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
 		components.ProvideTelemetrySink,
-		components.ProvideTelemetryService,
+		components.ProvideMetricsRegistry,
+		components.ProvideMetricsService,
+		components.ProvideTracingService,
+		components.ProvideProfiler,
+		components.ProvideLogExporter,
 		components.ProvideTrustedSetup,
+		components.ProvideValidatorMonitor,
 		components.ProvideValidatorService,
 		components.ProvideShutDownService,
 	}
@@ -67,13 +75,16 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideNodeAPIBackend,
 	)
 	c = append(c, components.ProvideNodeAPIHandlers,
+		components.ProvideNodeAPIAdminHandler,
 		components.ProvideNodeAPIBeaconHandler,
 		components.ProvideNodeAPIBuilderHandler,
 		components.ProvideNodeAPIConfigHandler,
 		components.ProvideNodeAPIDebugHandler,
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
+		components.ProvideNodeAPIProfilingHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 	return c
 }
@@ -82,9 +93,7 @@ func FixedComponents(t *testing.T) []any {
 func ProvideElectraGenesisChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	// Both Deneb1 and Electra happen in genesis.
-	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 0
+	setForkTimes(specData, 0, 0, 0)
 	// We set slots per epoch to 2 for faster observation of withdrawal behaviour
 	specData.SlotsPerEpoch = 2
 	// We set this to 4 so tests are faster
@@ -101,11 +110,8 @@ func ProvideElectraGenesisChainSpec() (chain.Spec, error) {
 // Bypasses the need for environment variables.
 func ProvideSimulationChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
-	specData.GenesisTime = 0
-	// Arbitrary number
-	specData.Deneb1ForkTime = 30
-	// High number as we don't want to activate electra.
-	specData.ElectraForkTime = 9999999999999999
+	// Deneb1 at an arbitrary number, Electra at a high number as we don't want to activate it.
+	setForkTimes(specData, 0, 30, 9999999999999999)
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -116,9 +122,7 @@ func ProvideSimulationChainSpec() (chain.Spec, error) {
 // ProvidePectraForkTestChainSpec provides a chain spec with pectra at timestamp 10
 func ProvidePectraForkTestChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
-	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 10
+	setForkTimes(specData, 0, 0, 10)
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -129,9 +133,7 @@ func ProvidePectraForkTestChainSpec() (chain.Spec, error) {
 // ProvidePectraWithdrawalTestChainSpec provides a chain spec used for withdrawal testing
 func ProvidePectraWithdrawalTestChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
-	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 10
+	setForkTimes(specData, 0, 0, 10)
 	// We set slots per epoch to 1 for faster observation of withdrawal behaviour
 	specData.SlotsPerEpoch = 1
 	// We set this to 4 so tests are faster
@@ -144,3 +146,10 @@ func ProvidePectraWithdrawalTestChainSpec() (chain.Spec, error) {
 	}
 	return chainSpec, nil
 }
+
+// setForkTimes sets the times of the genesis, Deneb1 and Electra forks of the testnet fork schedule.
+func setForkTimes(specData *chain.SpecData, genesisTime, deneb1ForkTime, electraForkTime uint64) {
+	specData.Forks[0].Timestamp = genesisTime
+	specData.Forks[1].Timestamp = deneb1ForkTime
+	specData.Forks[2].Timestamp = electraForkTime
+}
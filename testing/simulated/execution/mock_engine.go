@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package execution
+
+import (
+	"path/filepath"
+	"testing"
+
+	enginemock "github.com/berachain/beacon-kit/execution/engine-mock"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// StartMockEngine starts an in-process mock execution client on the given
+// execution genesis file and returns it along with its URL, which serves both
+// the Auth RPC and the EL RPC. Unlike the Docker nodes, the mock engine only
+// serves the Engine API calls and the deposit logs, so it cannot be used by
+// tests sending transactions.
+func StartMockEngine(
+	t *testing.T, genesisFile string, depositContract common.ExecutionAddress,
+) (*enginemock.Server, *url.ConnectionURL) {
+	t.Helper()
+
+	genesisBz, err := afero.ReadFile(afero.NewOsFs(), genesisFile)
+	require.NoError(t, err, "failed to read execution genesis")
+	genesis := &gethprimitives.Genesis{}
+	require.NoError(t, genesis.UnmarshalJSON(genesisBz), "failed to unmarshal execution genesis")
+
+	// Use the same JWT secret as the test nodes.
+	jwtPath, err := filepath.Abs("../files/jwt.hex")
+	require.NoError(t, err, "failed to determine absolute path for JWT secret")
+	jwtSecret, err := components.LoadJWTFromFile(jwtPath)
+	require.NoError(t, err, "failed to load JWT secret")
+
+	server, err := enginemock.New(enginemock.Config{
+		Genesis:         genesis,
+		DepositContract: depositContract,
+		JWTSecret:       jwtSecret,
+	})
+	require.NoError(t, err, "failed to create mock engine")
+	require.NoError(t, server.Start("127.0.0.1:0"), "failed to start mock engine")
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop())
+	})
+
+	rpcURL, err := url.NewFromRaw(server.URL())
+	require.NoError(t, err, "failed to create mock engine URL")
+	return server, rpcURL
+}
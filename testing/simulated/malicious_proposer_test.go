@@ -333,7 +333,7 @@ func (s *SimulatedSuite) TestProcessProposal_InvalidBlobCommitment_Errors() {
 
 	sidecarsSlice := make([]*datypes.BlobSidecar, len(blobs))
 	// Build Inclusion Proofs for Sidecars
-	sidecarFactory := dablob.NewSidecarFactory(metrics.NewNoOpTelemetrySink())
+	sidecarFactory := dablob.NewSidecarFactory(s.TestNode.ChainSpec, nil, metrics.NewNoOpTelemetrySink())
 	for i := range blobs {
 		inclusionProof, err := sidecarFactory.BuildKZGInclusionProof(proposedBlockMessage.GetBody(), math.U64(i))
 		s.Require().NoError(err)
@@ -497,7 +497,7 @@ func (s *SimulatedSuite) TestProcessProposal_InvalidBlobInclusionProof_Errors()
 
 	sidecarsSlice := make([]*datypes.BlobSidecar, len(blobs))
 	// Build Inclusion Proofs for Sidecars
-	sidecarFactory := dablob.NewSidecarFactory(metrics.NewNoOpTelemetrySink())
+	sidecarFactory := dablob.NewSidecarFactory(s.TestNode.ChainSpec, nil, metrics.NewNoOpTelemetrySink())
 	for i := range blobs {
 		inclusionProof, err := sidecarFactory.BuildKZGInclusionProof(proposedBlockMessage.GetBody(), math.U64(i))
 		s.Require().NoError(err)
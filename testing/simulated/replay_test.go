@@ -0,0 +1,161 @@
+//go:build simulated
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReplay_StoredBlocks_MatchCommittedStates replays the blocks of the chain
+// on top of a committed state and checks that every replayed state root
+// matches the one committed to by the block.
+func (s *SimulatedSuite) TestReplay_StoredBlocks_MatchCommittedStates() {
+	const blockHeight = 1
+	const coreLoopIterations = 5
+
+	// Initialize the chain state.
+	s.InitializeChain(s.T())
+
+	// Retrieve the BLS signer and proposer address.
+	blsSigner := simulated.GetBlsSigner(s.HomeDir)
+	pubkey, err := blsSigner.GetPubKey()
+	s.Require().NoError(err)
+
+	startTime := time.Now()
+	proposals, _, _ := s.MoveChainToHeight(s.T(), blockHeight, coreLoopIterations, blsSigner, startTime)
+	s.Require().Len(proposals, coreLoopIterations)
+
+	// The running node holds its database, so the replay runs on a copy of
+	// its home along with the blocks CometBFT would have stored.
+	replayHome := s.T().TempDir()
+	simulated.CopyHomeDir(s.T(), s.HomeDir, replayHome)
+	blockInterval := time.Duration(s.TestNode.ChainSpec.TargetSecondsPerEth1Block()) * time.Second
+	writeCometBlocks(s.T(), replayHome, blockHeight, proposals, startTime, blockInterval, pubkey.Address())
+
+	logBuffer := new(bytes.Buffer)
+	err = runReplay(s.T(), s.TestNode.ChainSpec, replayHome, logBuffer, "--from", "1", "--to", "5")
+	s.Require().NoError(err, logBuffer.String())
+	s.Require().Equal(coreLoopIterations-1, strings.Count(logBuffer.String(), "Replayed block"))
+
+	// Without the deposits of the state, the replay is refused up front.
+	s.Require().NoError(os.RemoveAll(filepath.Join(replayHome, "data", "deposits.db")))
+	logBuffer.Reset()
+	err = runReplay(s.T(), s.TestNode.ChainSpec, replayHome, logBuffer, "--from", "1", "--to", "5")
+	s.Require().ErrorIs(err, debug.ErrInvalidReplayRange)
+	s.Require().NotContains(logBuffer.String(), "Replayed block")
+}
+
+// writeCometBlocks stores the given proposals in the CometBFT block store of
+// the home directory, as finalized from the given height and time on.
+func writeCometBlocks(
+	t *testing.T,
+	homeDir string,
+	startHeight int64,
+	proposals []*abcitypes.PrepareProposalResponse,
+	startTime time.Time,
+	blockInterval time.Duration,
+	proposerAddress []byte,
+) {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, homeDir)
+	blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{
+		ID: "blockstore", Config: clicontext.GetConfigFromViper(v),
+	})
+	require.NoError(t, err)
+	blockStore := store.NewBlockStore(blockDB)
+	defer func() { require.NoError(t, blockStore.Close()) }()
+
+	blockTime := startTime
+	for i, proposal := range proposals {
+		height := startHeight + int64(i)
+		block := cmttypes.MakeBlock(height, cmttypes.ToTxs(proposal.Txs), &cmttypes.Commit{}, nil)
+		block.Time = blockTime
+		block.ProposerAddress = proposerAddress
+		parts, errParts := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, errParts)
+		blockStore.SaveBlock(block, parts, &cmttypes.Commit{Height: height, BlockID: cmttypes.BlockID{
+			Hash: block.Hash(), PartSetHeader: parts.Header(),
+		}})
+		blockTime = blockTime.Add(blockInterval)
+	}
+}
+
+// runReplay runs the replay command with the given arguments against the
+// node stored in the home directory, logging to the given writer.
+func runReplay(t *testing.T, cs chain.Spec, homeDir string, logs io.Writer, args ...string) error {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, homeDir)
+	logger := phuslu.NewLogger(logs, nil)
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, logger)
+
+	// The replay never calls the execution client, so it is left unreachable.
+	authRPC, err := url.NewFromRaw("http://localhost:0")
+	require.NoError(t, err)
+	appCreator := func(
+		logger *phuslu.Logger, database dbm.DB, _ io.Writer, cmtCfg *cmtcfg.Config, _ servertypes.AppOptions,
+	) nodetypes.Node {
+		components := simulated.FixedComponents(t)
+		components = append(components, simulated.ProvideSimComet, simulated.ProvideSimulationChainSpec)
+		return simulated.NewTestNodeWithDB(t, simulated.TestNodeInput{
+			TempHomeDir: homeDir,
+			CometConfig: cmtCfg,
+			AuthRPC:     authRPC,
+			Logger:      logger,
+			AppOpts:     viper.New(),
+			Components:  components,
+		}, database).Node
+	}
+
+	cmd := debug.NewReplayCmd(func(servertypes.AppOptions) (chain.Spec, error) { return cs, nil }, appCreator)
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	return cmd.ExecuteContext(ctx)
+}
@@ -27,12 +27,15 @@ import (
 
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/profiling"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -51,7 +54,10 @@ func ProvideSimComet(
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
-	telemetrySink *metrics.TelemetrySink) *SimComet {
+	telemetrySink *metrics.TelemetrySink,
+	profiler *profiling.Profiler,
+	chainSpec chain.Spec,
+) *SimComet {
 	return &SimComet{
 		cometbft.NewService(
 			logger,
@@ -60,7 +66,11 @@ func ProvideSimComet(
 			blockBuilder,
 			cmtCfg,
 			telemetrySink,
-			builder.DefaultServiceOptions(appOpts)...,
+			append(
+				builder.DefaultServiceOptions(appOpts),
+				cometbft.SetProfiler(profiler),
+				cometbft.SetForkSpec(chainSpec),
+			)...,
 		)}
 }
 
@@ -82,5 +92,25 @@ func (s *SimComet) CreateQueryContext(height int64, prove bool) (sdk.Context, er
 }
 
 func (s *SimComet) LastBlockHeight() int64 {
-	panic("unimplemented")
+	return s.Comet.LastBlockHeight()
+}
+
+func (s *SimComet) LightBlock(height int64, txIndex int) (*cmttypes.LightBlock, *cmttypes.TxProof, error) {
+	return s.Comet.LightBlock(height, txIndex)
+}
+
+func (s *SimComet) RequestHalt(height int64) error {
+	return s.Comet.RequestHalt(height)
+}
+
+func (s *SimComet) ExpectedProposer(height int64) ([]byte, error) {
+	return s.Comet.ExpectedProposer(height)
+}
+
+func (s *SimComet) IsCatchingUp() bool {
+	return false
+}
+
+func (s *SimComet) NetworkHeight() int64 {
+	return s.Comet.LastBlockHeight()
 }
@@ -37,8 +37,11 @@ import (
 	"github.com/berachain/beacon-kit/da/kzg"
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	adminapi "github.com/berachain/beacon-kit/node-api/handlers/admin"
+	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	nodecomponents "github.com/berachain/beacon-kit/node-core/components"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/node-core/services/validatormonitor"
 	nodetypes "github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/net/url"
@@ -81,19 +84,35 @@ func NewTestNode(
 	input TestNodeInput,
 ) TestNode {
 	t.Helper()
-	require.NotNil(t, input.AuthRPC)
 	require.NotNil(t, input.ClientRPC)
 
-	beaconKitConfig := createBeaconKitConfig(t)
-	beaconKitConfig.Engine.RPCDialURL = input.AuthRPC
-	appOpts := getAppOptions(t, input.AppOpts, beaconKitConfig, input.TempHomeDir)
-
 	// Create a database
 	database, err := db.OpenDB(input.TempHomeDir, dbm.PebbleDBBackend)
 	require.NoError(t, err)
 
 	// Build a node
-	node := buildNode(
+	node := NewTestNodeWithDB(t, input, database)
+	contractBackend, err := ethclient.Dial(input.ClientRPC.String())
+	require.NoError(t, err)
+	node.ContractBackend = contractBackend
+	return node
+}
+
+// NewTestNodeWithDB builds a node on top of the given database, the way the
+// app creator of the node commands does.
+func NewTestNodeWithDB(
+	t *testing.T,
+	input TestNodeInput,
+	database dbm.DB,
+) TestNode {
+	t.Helper()
+	require.NotNil(t, input.AuthRPC)
+
+	beaconKitConfig := createBeaconKitConfig(t)
+	beaconKitConfig.Engine.RPCDialURL = input.AuthRPC
+	appOpts := getAppOptions(t, input.AppOpts, beaconKitConfig, input.TempHomeDir)
+
+	return buildNode(
 		input.Logger,
 		database,
 		os.Stdout, // or some other writer
@@ -101,10 +120,6 @@ func NewTestNode(
 		appOpts,
 		input.Components,
 	)
-	contractBackend, err := ethclient.Dial(input.ClientRPC.String())
-	require.NoError(t, err)
-	node.ContractBackend = contractBackend
-	return node
 }
 
 // buildNode run the same logic as primary build, but it returns the components allowing us to query them.
@@ -119,6 +134,9 @@ func buildNode(
 	// variables to hold the components needed to set up BeaconApp
 	var (
 		apiBackend      nodecomponents.NodeAPIBackend
+		adminAPI        *adminapi.Handler
+		nodeAPI         *nodeapi.Handler
+		monitor         *validatormonitor.Monitor
 		beaconNode      nodetypes.Node
 		simComet        *SimComet
 		config          *config.Config
@@ -144,6 +162,9 @@ func buildNode(
 			),
 		),
 		&apiBackend,
+		&adminAPI,
+		&nodeAPI,
+		&monitor,
 		&beaconNode,
 		&simComet,
 		&config,
@@ -165,6 +186,9 @@ func buildNode(
 
 	logger.WithConfig(config.GetLogger())
 	apiBackend.AttachQueryBackend(simComet)
+	adminAPI.AttachNode(serviceRegistry, simComet)
+	nodeAPI.AttachConsensus(simComet)
+	monitor.AttachProposerSchedule(simComet)
 	return TestNode{
 		Node:            beaconNode,
 		StorageBackend:  storageBackend,
@@ -50,7 +50,7 @@ import (
 	"github.com/berachain/beacon-kit/testing/simulated/execution"
 	"github.com/cometbft/cometbft/abci/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -368,7 +368,7 @@ func GetProofAndCommitmentsForBlobs(
 	commitments := make([]eip4844.KZGCommitment, len(blobs))
 	proofs := make([]eip4844.KZGProof, len(blobs))
 	for i, blob := range blobs {
-		ckzgBlob := (*gokzg4844.Blob)(blob)
+		ckzgBlob := (*goethkzg.Blob)(blob)
 		commitment, err := gokzgVerifier.BlobToKZGCommitment(ckzgBlob, 1)
 		t.NoError(err)
 		proof, err := gokzgVerifier.ComputeBlobKZGProof(ckzgBlob, commitment, 1)